@@ -7,94 +7,263 @@ import (
 	"context"
 	"flag"
 	"log"
+	"log/slog"
+	"maps"
 	"net"
 	"os"
 	"os/signal"
-	"strconv"
-	"sync"
 	"syscall"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/backoff"
-	"google.golang.org/grpc/credentials/insecure"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
 
 	pb "github.com/open-edge-platform/o11y-tenant-controller/api"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/admin"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/alertingmonitor"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/archive"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/auth"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/backend"
 	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
 	"github.com/open-edge-platform/o11y-tenant-controller/internal/controller"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/health"
 	"github.com/open-edge-platform/o11y-tenant-controller/internal/jobs"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/leader"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/loki"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/mimir"
 	"github.com/open-edge-platform/o11y-tenant-controller/internal/projects"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/reconciler"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/reload"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/sre"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/store"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/tlsconfig"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/tracing"
 )
 
+// healthInterval is the period of updates of the gRPC health service.
+const healthInterval = 10 * time.Second
+
+// initialReconcileRetryPeriod is the period between attempts of the reconciliation run on taking over leadership.
+const initialReconcileRetryPeriod = 10 * time.Second
+
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "validate" {
+		os.Exit(validate(os.Args[2:], os.Stdout, os.Stderr))
+	}
+
 	cfgFilePath := flag.String("config", "", "path to the config file")
+	flagOverrides := config.RegisterFlags(flag.CommandLine)
 	flag.Parse()
 
-	cfg, err := config.ReadConfig(*cfgFilePath)
+	// Flags take precedence over environment variables, both over the config file.
+	overrides := config.EnvOverrides(os.LookupEnv)
+	maps.Copy(overrides, flagOverrides)
+	reloader, err := reload.New(*cfgFilePath, overrides)
 	if err != nil {
 		log.Panicf("Failed to load config: %v", err)
 	}
+	cfg := reloader.Config()
+	// Remaining packages logging with the log package write through the configured logger as well.
+	if err := logging.Setup(cfg.Logging); err != nil {
+		log.Panicf("Failed to set up logging: %v", err)
+	}
 
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
-	grpcServer := projects.Server{
-		GrpcServer: grpc.NewServer(),
-		Port:       50051,
-		Mu:         &sync.RWMutex{},
-		Projects:   make(map[string]projects.ProjectData),
-		Clients:    &sync.Map{},
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing)
+	if err != nil {
+		log.Panicf("Failed to set up tracing: %v", err)
 	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			log.Printf("Failed to flush spans: %v", err)
+		}
+	}()
 
-	lis, err := net.Listen("tcp", ":"+strconv.Itoa(grpcServer.Port))
+	serverCreds, err := tlsconfig.ServerCredentials(cfg.Server.TLS)
+	if err != nil {
+		log.Panicf("Failed to set up gRPC server TLS: %v", err)
+	}
+	// Project stream is limited to orgs granted by the token of the caller when authentication is enabled.
+	authenticator := auth.New(cfg.Server.Auth)
+	server := grpc.NewServer(grpc.Creds(serverCreds), grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(authenticator.UnaryInterceptor(pb.ProjectService_ServiceDesc.ServiceName)),
+		grpc.ChainStreamInterceptor(authenticator.StreamInterceptor(pb.ProjectService_ServiceDesc.ServiceName)))
+	grpcServer := projects.NewServer(server, cfg.Server.ChangeLog)
+
+	lis, err := net.Listen("tcp", cfg.Server.GrpcAddress)
 	if err != nil {
 		log.Panicf("Failed to listen: %v", err)
 	}
-	pb.RegisterProjectServiceServer(grpcServer.GrpcServer, &grpcServer)
+	pb.RegisterProjectServiceServer(grpcServer.GrpcServer, grpcServer)
 
-	go func() {
-		if err := grpcServer.GrpcServer.Serve(lis); err != nil {
-			log.Printf("gRPC server failed to serve: %v", err)
-			stop()
-		}
-	}()
-	log.Printf("gRPC server listening on port %d", grpcServer.Port)
+	checker := health.New(0)
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(grpcServer.GrpcServer, healthServer)
 
-	tenantCtrl, err := controller.New(cfg.Controller.Channel.MaxInflightRequests, cfg.Controller.CreateDeleteWatcherTimeout, &grpcServer)
+	tenantCtrl, err := controller.New(cfg.Controller.Channel.MaxInflightRequests, cfg.Controller.CreateDeleteWatcherTimeout,
+		cfg.Controller.Intake, cfg.Server.MetricsAddress, grpcServer, checker)
 	if err != nil {
 		log.Panicf("Failed to create tenant controller: %v", err)
 	}
 
-	amConn, err := grpc.NewClient(cfg.Endpoints.AlertingMonitor,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithConnectParams(grpc.ConnectParams{Backoff: backoff.DefaultConfig}),
-	)
+	amConn, err := backend.NewConn(cfg.Endpoints.AlertingMonitor, cfg.Endpoints.TLS.AlertingMonitor, nil)
 	if err != nil {
 		log.Panicf("Failed to create alerting monitor gRPC client: %v", err)
 	}
 	defer amConn.Close()
 
-	sreConn, err := grpc.NewClient(cfg.Endpoints.Sre,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithConnectParams(grpc.ConnectParams{Backoff: backoff.DefaultConfig}),
-	)
+	sreConn, err := backend.NewConn(cfg.Endpoints.Sre, cfg.Endpoints.TLS.Sre, nil)
 	if err != nil {
 		log.Panicf("Failed to create sre-exporter gRPC client: %v", err)
 	}
+	defer sreConn.Close()
+
+	// New backends are enabled by adding their factory here and listing them in endpoints.backends config.
+	factories := map[string]backend.Factory{
+		config.BackendAlertingMonitor: alertingmonitor.NewBackend,
+		config.BackendSre:             sre.NewBackend,
+		config.BackendLoki:            loki.NewBackend,
+		config.BackendMimir:           mimir.NewBackend,
+	}
+
+	backends, err := backend.NewRegistry(factories, backend.Dependencies{
+		Endpoints: cfg.Endpoints,
+		AmConn:    amConn,
+		SreConn:   sreConn,
+	}, backend.Names(cfg))
+	if err != nil {
+		log.Panicf("Failed to create tenant backends: %v", err)
+	}
+
+	jobStore, err := store.New(cfg.Job.Store)
+	if err != nil {
+		log.Panicf("Failed to create job store: %v", err)
+	}
+
+	var archiver jobs.Archiver
+	if cfg.Job.Archive.Enabled {
+		sink, err := archive.NewSink(cfg.Job.Archive.Sink)
+		if err != nil {
+			log.Panicf("Failed to create archive sink: %v", err)
+		}
+		a, err := archive.New(cfg.Job.Archive, cfg.Endpoints, sink)
+		if err != nil {
+			log.Panicf("Failed to create archiver: %v", err)
+		}
+		archiver = a
+	}
+
+	jobManager := jobs.New(tenantCtrl.ComSig, cfg.Job, backends, jobStore, archiver, tenantCtrl.GetProject)
+	prometheus.MustRegister(jobManager)
+
+	checker.AddLiveness("jobLoop", jobManager.Alive)
+	checker.AddReadiness("informers", tenantCtrl.Synced)
+	checker.AddReadiness("projectWatcher", tenantCtrl.WatcherRegistered)
+	// Backends are needed by tenant jobs only, so their outage must not take ProjectService out of the Service endpoints.
+	for _, b := range backends {
+		checker.AddDependency(b.Name(), b.Verify)
+	}
+	// TenantAdmin is not authenticated, so it is served apart from ProjectService, reachable by operators only.
+	adminServer := grpc.NewServer(grpc.Creds(serverCreds), grpc.StatsHandler(otelgrpc.NewServerHandler()))
+	pb.RegisterTenantAdminServer(adminServer, admin.NewServer(jobManager, leader.Current))
+	healthpb.RegisterHealthServer(adminServer, healthServer)
+	adminLis, err := net.Listen("tcp", cfg.Server.AdminAddress)
+	if err != nil {
+		log.Panicf("Failed to listen on admin address: %v", err)
+	}
+	// Services must be registered before the gRPC servers start serving. Reflection lets tools like grpcurl discover
+	// the services without proto files.
+	reflection.Register(grpcServer.GrpcServer)
+	reflection.Register(adminServer)
+
+	go func() {
+		if err := grpcServer.GrpcServer.Serve(lis); err != nil {
+			log.Printf("gRPC server failed to serve: %v", err)
+			stop()
+		}
+	}()
+	log.Printf("gRPC server listening on %v", cfg.Server.GrpcAddress)
+	go func() {
+		if err := adminServer.Serve(adminLis); err != nil {
+			slog.Error("Admin gRPC server failed to serve", "address", cfg.Server.AdminAddress, logging.Err(err))
+			stop()
+		}
+	}()
+	defer adminServer.Stop()
+	slog.Info("Admin gRPC server listening", "address", cfg.Server.AdminAddress)
 
 	err = tenantCtrl.Start()
-	// defer before checking error done on purpose - to ensure cleanup (Start may fail for reasons other than an error at addProjectWatcher).
+	// defer before checking error done on purpose - to ensure cleanup (Start may fail after some callbacks are registered).
 	defer tenantCtrl.Stop()
+	// Deferred after Stop, so clients watching health get NOT_SERVING before the gRPC server stops.
+	defer healthServer.Shutdown()
 	if err != nil {
 		log.Panicf("Failed to start tenant controller: %v", err)
 	}
+	go checker.Serve(ctx, healthServer, healthInterval, pb.ProjectService_ServiceDesc.ServiceName, pb.TenantAdmin_ServiceDesc.ServiceName)
+	go reloader.Run(ctx, applyConfig(jobManager, backends))
+
+	identity, err := leader.Identity()
+	if err != nil {
+		log.Panicf("Failed to determine controller identity: %v", err)
+	}
+	var lock resourcelock.Interface
+	if cfg.Controller.LeaderElection.Enabled {
+		lock, err = leader.NewLock(cfg.Controller.LeaderElection, identity)
+		if err != nil {
+			log.Panicf("Failed to create leader election lock: %v", err)
+		}
+	}
+
+	// All replicas serve the project stream, while only the leader runs tenant jobs and manages the project watcher.
+	err = leader.Run(ctx, cfg.Controller.LeaderElection, lock, func(leaderCtx context.Context) {
+		if err := tenantCtrl.StartLeading(); err != nil {
+			slog.Error("Failed to start leading", logging.Err(err))
+			stop()
+			return
+		}
 
-	ticker := time.NewTicker(cfg.Job.Manager.Deletion.Rate)
-	defer ticker.Stop()
+		// Deletion rate may have been reloaded since start.
+		ticker := time.NewTicker(reloader.Config().Job.Manager.Deletion.Rate)
+		defer ticker.Stop()
 
-	jobManager := jobs.New(tenantCtrl.ComSig, cfg.Job, cfg.Endpoints, amConn, sreConn)
-	jobManager.Start(ticker)
+		jobManager.Start(ticker)
+
+		projectReconciler := reconciler.New(tenantCtrl.ComSig, cfg.Controller.Reconcile, tenantCtrl.ListProjects, jobManager)
+		// Project events are dropped before taking over, so actions for all projects are enqueued right away. They are
+		// enqueued by nothing else when periodic reconciliation is disabled, so failures are retried until leading ends.
+		if err := projectReconciler.ReconcileUntilSucceeded(leaderCtx, initialReconcileRetryPeriod); err != nil {
+			slog.Error("Initial reconciliation stopped", logging.Err(err))
+		}
+		projectReconciler.Start()
 
+		orphanScanner := reconciler.NewOrphanScanner(cfg.Controller.Orphans, reconciler.TenantListers(backends), tenantCtrl.ListProjects,
+			jobManager)
+		orphanScanner.Start()
+
+		<-leaderCtx.Done()
+		// Reconcilers must stop before the tenant controller closes the channel they send to.
+		projectReconciler.Stop()
+		orphanScanner.Stop()
+		// Replica taking over leadership keeps using the project watcher. Without one, e.g. when the last replica shuts
+		// down, it is deleted, so it does not hold up deletion of projects.
+		tenantCtrl.StopLeading(!leader.Successor(ctx))
+		jobManager.Stop()
+	})
+	if err != nil {
+		// Jobs cannot be restarted within the process, it exits and rejoins the election after restart.
+		slog.Error("Leader election failed", logging.Err(err))
+		stop()
+	}
 	<-ctx.Done()
-	jobManager.Stop()
 }
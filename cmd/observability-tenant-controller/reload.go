@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"log/slog"
+	"reflect"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/backend"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/jobs"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/reload"
+)
+
+// applyConfig returns function applying reloaded config to the running components without interrupting jobs.
+// Changes of settings used only on start are reported as requiring restart.
+func applyConfig(jobManager *jobs.JobManager, backends []backend.TenantBackend) reload.ApplyFunc {
+	return func(prev, next config.Config) {
+		if err := logging.Setup(next.Logging); err != nil {
+			slog.Error("Failed to apply logging config", logging.Err(err))
+		}
+		jobManager.UpdateConfig(next.Job)
+		for _, b := range backends {
+			if r, ok := b.(backend.Reconfigurable); ok {
+				r.Reconfigure(next.Endpoints)
+			}
+		}
+
+		for _, field := range restartRequired(prev, next) {
+			slog.Warn("Config change takes effect after restart", "field", field)
+		}
+	}
+}
+
+// restartRequired returns config sections that changed, but are only read on start.
+func restartRequired(prev, next config.Config) []string {
+	sections := []struct {
+		field      string
+		prev, next any
+	}{
+		{"server", prev.Server, next.Server},
+		{"endpoints.backends", prev.Endpoints.Backends, next.Endpoints.Backends},
+		// Archiver queries backends with endpoints given on start.
+		{"endpoints.mimir.query", prev.Endpoints.Mimir.Query, next.Endpoints.Mimir.Query},
+		{"endpoints.loki.read", prev.Endpoints.Loki.Read, next.Endpoints.Loki.Read},
+		{"controller", prev.Controller, next.Controller},
+		{"job.sre", prev.Job.Sre, next.Job.Sre},
+		{"job.store", prev.Job.Store, next.Job.Store},
+		{"job.archive", prev.Job.Archive, next.Job.Archive},
+		{"tracing", prev.Tracing, next.Tracing},
+	}
+
+	var changed []string
+	for _, s := range sections {
+		if !reflect.DeepEqual(s.prev, s.next) {
+			changed = append(changed, s.field)
+		}
+	}
+	return changed
+}
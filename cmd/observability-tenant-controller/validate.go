@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+)
+
+// validate checks the config file without starting the controller and returns the exit code.
+// Path is given by the --config flag or as the only argument. Overrides given by environment and flags are validated too.
+func validate(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("validate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	cfgFilePath := fs.String("config", "", "path to the config file")
+	flagOverrides := config.RegisterFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if *cfgFilePath == "" && fs.NArg() == 1 {
+		*cfgFilePath = fs.Arg(0)
+	}
+	if *cfgFilePath == "" || fs.NArg() > 1 {
+		fmt.Fprintln(stderr, "usage: observability-tenant-controller validate --config <path>")
+		return 2
+	}
+
+	overrides := config.EnvOverrides(os.LookupEnv)
+	maps.Copy(overrides, flagOverrides)
+	if _, err := config.ReadConfig(*cfgFilePath, overrides); err != nil {
+		fmt.Fprintln(stderr, err)
+		return 1
+	}
+	fmt.Fprintf(stdout, "Config %q is valid\n", *cfgFilePath)
+	return 0
+}
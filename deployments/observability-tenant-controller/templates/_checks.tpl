@@ -6,4 +6,7 @@
   {{ if not (mustHas .Values.mimir.deleteVerifyMode $verifyModes) }}
   {{ fail "please provide correct .Values.mimir.deleteVerifyMode value" }}
   {{ end }}
+  {{ if and (gt (int .Values.replicaCount) 1) (not .Values.leaderElection.enabled) }}
+  {{ fail "multiple replicas require .Values.leaderElection.enabled" }}
+  {{ end }}
 {{ end }}
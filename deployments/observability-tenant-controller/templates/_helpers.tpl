@@ -50,6 +50,13 @@ gRPC port definition
   50051
 {{- end -}}
 
+{{/*
+TenantAdmin gRPC port definition
+*/}}
+{{- define "observability-tenant-controller.ports.admin" -}}
+  50052
+{{- end -}}
+
 {{/*
 Prometheus port definition
 */}}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/open-edge-platform/o11y-tenant-controller/api"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/jobs"
+)
+
+// JobAdmin is the part of jobs.JobManager exposed by the TenantAdmin service.
+type JobAdmin interface {
+	Jobs() []jobs.JobInfo
+	Job(tenantID string) (jobs.JobInfo, error)
+	RetryJob(tenantID string) (jobs.JobInfo, error)
+	CancelJob(tenantID string) (jobs.JobInfo, error)
+}
+
+// LeaderFunc returns identity of the current leader, empty until one is elected, and whether this replica leads.
+type LeaderFunc func() (identity string, leading bool)
+
+type Server struct {
+	pb.UnimplementedTenantAdminServer
+
+	jobs   JobAdmin
+	leader LeaderFunc
+}
+
+// NewServer creates TenantAdmin server. Jobs are held by the leader only, other replicas reject calls naming it.
+func NewServer(jobAdmin JobAdmin, leader LeaderFunc) *Server {
+	return &Server{jobs: jobAdmin, leader: leader}
+}
+
+func (s *Server) ListJobs(_ context.Context, _ *pb.ListJobsRequest) (*pb.ListJobsResponse, error) {
+	if err := s.checkLeader(); err != nil {
+		return nil, err
+	}
+	infos := s.jobs.Jobs()
+	resp := &pb.ListJobsResponse{Jobs: make([]*pb.Job, 0, len(infos))}
+	for _, info := range infos {
+		resp.Jobs = append(resp.Jobs, toJob(info))
+	}
+	return resp, nil
+}
+
+func (s *Server) GetJob(_ context.Context, req *pb.JobRequest) (*pb.Job, error) {
+	return s.handle(req, s.jobs.Job)
+}
+
+func (s *Server) RetryJob(_ context.Context, req *pb.JobRequest) (*pb.Job, error) {
+	return s.handle(req, s.jobs.RetryJob)
+}
+
+func (s *Server) CancelJob(_ context.Context, req *pb.JobRequest) (*pb.Job, error) {
+	return s.handle(req, s.jobs.CancelJob)
+}
+
+func (s *Server) handle(req *pb.JobRequest, fn func(tenantID string) (jobs.JobInfo, error)) (*pb.Job, error) {
+	if req.GetTenantId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "tenant_id cannot be empty")
+	}
+	if err := s.checkLeader(); err != nil {
+		return nil, err
+	}
+
+	info, err := fn(req.GetTenantId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toJob(info), nil
+}
+
+// checkLeader rejects calls to replicas not running tenant jobs, naming the leader that does.
+func (s *Server) checkLeader() error {
+	identity, leading := s.leader()
+	switch {
+	case leading:
+		return nil
+	case identity == "":
+		return status.Error(codes.Unavailable, "no leader elected yet, tenant jobs run on the leader only")
+	default:
+		return status.Errorf(codes.FailedPrecondition, "tenant jobs run on the leader %q, call its TenantAdmin", identity)
+	}
+}
+
+func toStatus(err error) error {
+	switch {
+	case errors.Is(err, jobs.ErrJobNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, jobs.ErrJobFinished), errors.Is(err, jobs.ErrJobNotRunning):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
+func toJob(info jobs.JobInfo) *pb.Job {
+	steps := make([]*pb.BackendStep, 0, len(info.Steps))
+	for _, step := range info.Steps {
+		steps = append(steps, &pb.BackendStep{
+			Backend: step.Backend,
+			State:   step.State,
+		})
+	}
+
+	job := &pb.Job{
+		TenantId:    info.TenantID,
+		ProjectName: info.ProjectName,
+		OrgName:     info.OrgName,
+		Action:      info.Action,
+		Status:      info.Status,
+		//nolint:gosec // Number of attempts does not come close to int32 limits.
+		Attempts:  int32(info.Attempts),
+		LastError: info.LastError,
+		Steps:     steps,
+		Archive:   info.Archive,
+		Orphan:    info.Orphan,
+	}
+	if !info.DeleteAt.IsZero() {
+		job.DeleteAt = info.DeleteAt.Format(time.RFC3339)
+	}
+	return job
+}
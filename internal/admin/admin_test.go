@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package admin
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/open-edge-platform/o11y-tenant-controller/api"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/jobs"
+)
+
+type fakeJobAdmin struct {
+	infos map[string]jobs.JobInfo
+	err   error
+}
+
+func (f *fakeJobAdmin) Jobs() []jobs.JobInfo {
+	infos := make([]jobs.JobInfo, 0, len(f.infos))
+	for _, info := range f.infos {
+		infos = append(infos, info)
+	}
+	return infos
+}
+
+func (f *fakeJobAdmin) Job(tenantID string) (jobs.JobInfo, error) {
+	if f.err != nil {
+		return jobs.JobInfo{}, f.err
+	}
+	info, ok := f.infos[tenantID]
+	if !ok {
+		return jobs.JobInfo{}, fmt.Errorf("%w: tenantID %q", jobs.ErrJobNotFound, tenantID)
+	}
+	return info, nil
+}
+
+func (f *fakeJobAdmin) RetryJob(tenantID string) (jobs.JobInfo, error) {
+	return f.Job(tenantID)
+}
+
+func (f *fakeJobAdmin) CancelJob(tenantID string) (jobs.JobInfo, error) {
+	return f.Job(tenantID)
+}
+
+func leading() (string, bool) {
+	return "first", true
+}
+
+func TestListJobs(t *testing.T) {
+	s := NewServer(&fakeJobAdmin{infos: map[string]jobs.JobInfo{
+		"foo": {
+			TenantID:  "foo",
+			Action:    "CleanupTenant",
+			Status:    "in_progress",
+			Attempts:  3,
+			LastError: "backend failure",
+			Steps:     []jobs.StepInfo{{Backend: "loki", State: "failed"}},
+			DeleteAt:  time.Date(2025, time.January, 2, 3, 4, 5, 0, time.UTC),
+			Archive:   "s3://bucket/foo",
+		},
+	}}, leading)
+
+	resp, err := s.ListJobs(t.Context(), &pb.ListJobsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetJobs(), 1)
+
+	job := resp.GetJobs()[0]
+	require.Equal(t, "foo", job.GetTenantId())
+	require.Equal(t, "CleanupTenant", job.GetAction())
+	require.Equal(t, "in_progress", job.GetStatus())
+	require.Equal(t, int32(3), job.GetAttempts())
+	require.Equal(t, "backend failure", job.GetLastError())
+	require.Len(t, job.GetSteps(), 1)
+	require.Equal(t, "loki", job.GetSteps()[0].GetBackend())
+	require.Equal(t, "failed", job.GetSteps()[0].GetState())
+	require.Equal(t, "2025-01-02T03:04:05Z", job.GetDeleteAt())
+	require.Equal(t, "s3://bucket/foo", job.GetArchive())
+}
+
+func TestJobRequests(t *testing.T) {
+	tests := []struct {
+		name     string
+		tenantID string
+		err      error
+		code     codes.Code
+	}{
+		{name: "Existing job", tenantID: "foo", code: codes.OK},
+		{name: "Empty tenantID", tenantID: "", code: codes.InvalidArgument},
+		{name: "Unknown job", tenantID: "bar", code: codes.NotFound},
+		{name: "Finished job", tenantID: "foo", err: jobs.ErrJobFinished, code: codes.FailedPrecondition},
+		{name: "Job not running", tenantID: "foo", err: jobs.ErrJobNotRunning, code: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewServer(&fakeJobAdmin{infos: map[string]jobs.JobInfo{"foo": {TenantID: "foo"}}, err: tt.err}, leading)
+			req := &pb.JobRequest{TenantId: tt.tenantID}
+
+			for _, call := range []func() (*pb.Job, error){
+				func() (*pb.Job, error) { return s.GetJob(t.Context(), req) },
+				func() (*pb.Job, error) { return s.RetryJob(t.Context(), req) },
+				func() (*pb.Job, error) { return s.CancelJob(t.Context(), req) },
+			} {
+				job, err := call()
+				require.Equal(t, tt.code, status.Code(err), "Status code different from expected")
+				if tt.code == codes.OK {
+					require.Equal(t, tt.tenantID, job.GetTenantId())
+				}
+			}
+		})
+	}
+}
+
+func TestNotLeader(t *testing.T) {
+	tests := []struct {
+		name     string
+		identity string
+		code     codes.Code
+		message  string
+	}{
+		{name: "Other replica leads", identity: "second", code: codes.FailedPrecondition, message: `"second"`},
+		{name: "No leader elected", identity: "", code: codes.Unavailable, message: "no leader elected"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := NewServer(&fakeJobAdmin{infos: map[string]jobs.JobInfo{"foo": {TenantID: "foo"}}}, func() (string, bool) {
+				return tt.identity, false
+			})
+			req := &pb.JobRequest{TenantId: "foo"}
+
+			_, err := s.ListJobs(t.Context(), &pb.ListJobsRequest{})
+			require.Equal(t, tt.code, status.Code(err), "Status code different from expected")
+			require.Contains(t, status.Convert(err).Message(), tt.message)
+			for _, call := range []func() (*pb.Job, error){
+				func() (*pb.Job, error) { return s.GetJob(t.Context(), req) },
+				func() (*pb.Job, error) { return s.RetryJob(t.Context(), req) },
+				func() (*pb.Job, error) { return s.CancelJob(t.Context(), req) },
+			} {
+				_, err := call()
+				require.Equal(t, tt.code, status.Code(err), "Status code different from expected")
+				require.Contains(t, status.Convert(err).Message(), tt.message)
+			}
+		})
+	}
+}
@@ -6,12 +6,12 @@ package alertingmonitor
 import (
 	"context"
 	"fmt"
-	"log"
 
 	proto "github.com/open-edge-platform/o11y-alerting-monitor/api/v1/management"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
 	"github.com/open-edge-platform/o11y-tenant-controller/internal/util"
 )
 
@@ -21,16 +21,17 @@ func InitializeTenant(ctx context.Context, am proto.ManagementClient) error {
 		return fmt.Errorf("failed to retrieve %q from context", utility.ContextKeyTenantID)
 	}
 
-	log.Printf("Creating tenantID %q in alerting monitor", tenantID)
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "Creating tenant in alerting monitor")
 	_, err := am.InitializeTenant(ctx, &proto.TenantRequest{Tenant: tenantID})
 	if status.Code(err) == codes.AlreadyExists {
-		log.Printf("TenantID %q already initialized in alerting monitor", tenantID)
+		logger.InfoContext(ctx, "Tenant already initialized in alerting monitor")
 		return nil
 	} else if err != nil {
 		return fmt.Errorf("failed to initialize tenantID %q in alerting monitor: %w", tenantID, err)
 	}
 
-	log.Printf("TenantID %q initialized in alerting monitor", tenantID)
+	logger.InfoContext(ctx, "Tenant initialized in alerting monitor")
 	return nil
 }
 
@@ -40,15 +41,16 @@ func CleanupTenant(ctx context.Context, am proto.ManagementClient) error {
 		return fmt.Errorf("failed to retrieve %q from context", utility.ContextKeyTenantID)
 	}
 
-	log.Printf("Deleting tenantID %q in alerting monitor", tenantID)
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "Deleting tenant in alerting monitor")
 	_, err := am.CleanupTenant(ctx, &proto.TenantRequest{Tenant: tenantID})
 	if status.Code(err) == codes.NotFound {
-		log.Printf("TenantID %q already deleted in alerting monitor", tenantID)
+		logger.InfoContext(ctx, "Tenant already deleted in alerting monitor")
 		return nil
 	} else if err != nil {
 		return fmt.Errorf("failed to delete tenantID %q in alerting monitor: %w", tenantID, err)
 	}
 
-	log.Printf("TenantID %q deleted in alerting monitor", tenantID)
+	logger.InfoContext(ctx, "Tenant deleted in alerting monitor")
 	return nil
 }
@@ -0,0 +1,292 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package archive
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"os"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/util"
+)
+
+const (
+	defaultWindow     = time.Hour
+	defaultLokiQuery  = `{service_name=~".+"}`
+	defaultLokiLimit  = 5000
+	defaultMimirQuery = `{__name__=~".+"}`
+	defaultMimirStep  = time.Minute
+
+	logsObject    = "logs.jsonl"
+	metricsObject = "metrics.jsonl"
+	timeLayout    = "20060102T150405Z"
+)
+
+// Archiver exports tenant logs and metrics to a sink.
+type Archiver struct {
+	cfg      config.Archive
+	lokiURL  string
+	mimirURL string
+	sink     Sink
+}
+
+type queryResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		Result json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+// New creates archiver of the tenant data stored in Loki and Mimir. Unset query parameters fall back to defaults.
+func New(cfg config.Archive, endpoints config.Endpoints, sink Sink) (*Archiver, error) {
+	if cfg.Lookback <= 0 {
+		return nil, errors.New("archive lookback must be greater than zero")
+	}
+	if endpoints.Loki.Read == "" || endpoints.Mimir.Query == "" {
+		return nil, errors.New("loki read and mimir query endpoints are required by archive")
+	}
+
+	if cfg.Window <= 0 {
+		cfg.Window = defaultWindow
+	}
+	if cfg.Loki.Query == "" {
+		cfg.Loki.Query = defaultLokiQuery
+	}
+	if cfg.Loki.Limit <= 0 {
+		cfg.Loki.Limit = defaultLokiLimit
+	}
+	if cfg.Mimir.Query == "" {
+		cfg.Mimir.Query = defaultMimirQuery
+	}
+	if cfg.Mimir.Step <= 0 {
+		cfg.Mimir.Step = defaultMimirStep
+	}
+
+	return &Archiver{
+		cfg:      cfg,
+		lokiURL:  endpoints.Loki.Read,
+		mimirURL: endpoints.Mimir.Query,
+		sink:     sink,
+	}, nil
+}
+
+// Progress is the checkpoint of a tenant archive. Windows completed before are not exported again when the archive resumes.
+type Progress struct {
+	// To is the end of the archived time range, set when the archive starts.
+	To time.Time
+	// Windows is the number of windows exported so far.
+	Windows int
+}
+
+// Archive exports logs and metrics of the tenant window by window and returns location of the archive. Export resumes
+// after the windows completed in progress, checkpoint is called once every window is exported.
+func (a *Archiver) Archive(ctx context.Context, tenantID string, progress Progress, checkpoint func(Progress)) (string, error) {
+	if progress.To.IsZero() {
+		progress = Progress{To: time.Now()}
+	}
+	from := progress.To.Add(-a.cfg.Lookback)
+	prefix := path.Join(tenantID, progress.To.UTC().Format(timeLayout))
+	slog.InfoContext(ctx, "Archiving tenant data", logging.KeyTenantID, tenantID, "location", a.sink.Location(prefix),
+		"windows", windows(a.cfg.Lookback, a.cfg.Window), "completed", progress.Windows)
+
+	for start := from.Add(time.Duration(progress.Windows) * a.cfg.Window); start.Before(progress.To); start = start.Add(a.cfg.Window) {
+		end := start.Add(a.cfg.Window)
+		if end.After(progress.To) {
+			end = progress.To
+		}
+		window := path.Join(prefix, start.UTC().Format(timeLayout))
+
+		if err := a.export(ctx, path.Join(window, logsObject), func(w io.Writer) error {
+			return a.exportLogs(ctx, w, tenantID, start, end)
+		}); err != nil {
+			return "", fmt.Errorf("failed to archive logs for tenantID %q: %w", tenantID, err)
+		}
+
+		if err := a.export(ctx, path.Join(window, metricsObject), func(w io.Writer) error {
+			return a.exportMetrics(ctx, w, tenantID, start, end)
+		}); err != nil {
+			return "", fmt.Errorf("failed to archive metrics for tenantID %q: %w", tenantID, err)
+		}
+
+		progress.Windows++
+		checkpoint(progress)
+	}
+
+	slog.InfoContext(ctx, "Tenant data archived", logging.KeyTenantID, tenantID, "location", a.sink.Location(prefix))
+	return a.sink.Location(prefix), nil
+}
+
+// windows returns the number of windows the lookback is split into.
+func windows(lookback, window time.Duration) int {
+	if window <= 0 {
+		window = defaultWindow
+	}
+	return int((lookback + window - 1) / window)
+}
+
+// export writes data into a temporary file first, so the sink receives object of known size.
+func (a *Archiver) export(ctx context.Context, key string, write func(w io.Writer) error) error {
+	tmp, err := os.CreateTemp("", "archive-*.jsonl")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	w := bufio.NewWriter(tmp)
+	if err := write(w); err != nil {
+		return err
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to rewind temporary file: %w", err)
+	}
+	return a.sink.Put(ctx, key, tmp)
+}
+
+// exportLogs writes log streams of the window as JSON lines. The window is paged through, as Loki caps the number
+// of returned entries. Every page starts at the timestamp of the last entry of the previous one, so entries sharing
+// that timestamp are not lost, and the entries already written are skipped.
+func (a *Archiver) exportLogs(ctx context.Context, w io.Writer, tenantID string, start, end time.Time) error {
+	enc := json.NewEncoder(w)
+	cursor := start.UnixNano()
+	// written holds entries at the cursor timestamp that are already exported.
+	written := map[string]struct{}{}
+	for {
+		params := url.Values{}
+		params.Set("query", a.cfg.Loki.Query)
+		params.Set("start", strconv.FormatInt(cursor, 10))
+		params.Set("end", strconv.FormatInt(end.UnixNano(), 10))
+		params.Set("limit", strconv.Itoa(a.cfg.Loki.Limit))
+		params.Set("direction", "forward")
+
+		var streams []lokiStream
+		if err := query(ctx, fmt.Sprintf("%v/loki/api/v1/query_range?%v", a.lokiURL, params.Encode()), tenantID, &streams); err != nil {
+			return err
+		}
+
+		type entry struct {
+			ts  int64
+			key string
+		}
+		var page []entry
+		next := cursor
+		exported := 0
+		for _, s := range streams {
+			labels, err := json.Marshal(s.Stream)
+			if err != nil {
+				return fmt.Errorf("failed to marshal log stream labels: %w", err)
+			}
+
+			values := make([][2]string, 0, len(s.Values))
+			for _, v := range s.Values {
+				ts, err := strconv.ParseInt(v[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("failed to parse log entry timestamp %q: %w", v[0], err)
+				}
+				e := entry{ts: ts, key: string(labels) + "\x00" + v[1]}
+				page = append(page, e)
+				if _, ok := written[e.key]; ok && ts == cursor {
+					continue
+				}
+				next = max(next, ts)
+				values = append(values, v)
+			}
+			if len(values) == 0 {
+				continue
+			}
+
+			exported += len(values)
+			if err := enc.Encode(lokiStream{Stream: s.Stream, Values: values}); err != nil {
+				return fmt.Errorf("failed to write logs: %w", err)
+			}
+		}
+
+		if len(page) < a.cfg.Loki.Limit {
+			return nil
+		}
+		if exported == 0 {
+			// Whole page shares the cursor timestamp, Loki cannot page through it.
+			slog.WarnContext(ctx, "More log entries share the timestamp than the query limit - skipping the rest",
+				logging.KeyTenantID, tenantID, "timestamp", cursor, "limit", a.cfg.Loki.Limit)
+			cursor++
+			clear(written)
+			continue
+		}
+
+		if next != cursor {
+			cursor = next
+			clear(written)
+		}
+		for _, e := range page {
+			if e.ts == cursor {
+				written[e.key] = struct{}{}
+			}
+		}
+	}
+}
+
+// exportMetrics writes series of the window as JSON lines, one line per series.
+func (a *Archiver) exportMetrics(ctx context.Context, w io.Writer, tenantID string, start, end time.Time) error {
+	params := url.Values{}
+	params.Set("query", a.cfg.Mimir.Query)
+	params.Set("start", strconv.FormatInt(start.Unix(), 10))
+	params.Set("end", strconv.FormatInt(end.Unix(), 10))
+	params.Set("step", strconv.FormatFloat(a.cfg.Mimir.Step.Seconds(), 'f', -1, 64))
+
+	var series []json.RawMessage
+	if err := query(ctx, fmt.Sprintf("%v/prometheus/api/v1/query_range?%v", a.mimirURL, params.Encode()), tenantID, &series); err != nil {
+		return err
+	}
+
+	for _, s := range series {
+		if _, err := fmt.Fprintf(w, "%s\n", s); err != nil {
+			return fmt.Errorf("failed to write metrics: %w", err)
+		}
+	}
+	return nil
+}
+
+// query calls Prometheus-compatible query API and unmarshals result of the successful query.
+func query(ctx context.Context, urlRaw, tenantID string, result any) error {
+	body, err := utility.GetReq(ctx, urlRaw, tenantID)
+	if err != nil {
+		return err
+	}
+
+	var resp queryResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+	if resp.Status != "success" {
+		return fmt.Errorf("query failed with status %q", resp.Status)
+	}
+	if len(resp.Data.Result) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(resp.Data.Result, result); err != nil {
+		return fmt.Errorf("failed to unmarshal query result: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,236 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package archive
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+)
+
+const tenantID = "tenant"
+
+func TestArchive(t *testing.T) {
+	// Entries are offsets from the window start. Two of them share timestamp of the last entry of the first page.
+	entries := []struct {
+		offset  int64
+		service string
+		line    string
+	}{
+		{0, "foo", "first"}, {0, "foo", "second"}, {1, "foo", "third"}, {1, "bar", "fourth"}, {2, "bar", "fifth"},
+	}
+	var base int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Scope-OrgID") != tenantID {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch r.URL.Path {
+		case "/loki/api/v1/query_range":
+			start, err := strconv.ParseInt(r.URL.Query().Get("start"), 10, 64)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			if base == 0 {
+				base = start
+			}
+
+			var result []string
+			for _, e := range entries {
+				if base+e.offset < start || len(result) == limit {
+					continue
+				}
+				result = append(result, fmt.Sprintf(`{"stream":{"service_name":%q},"values":[["%d",%q]]}`,
+					e.service, base+e.offset, e.line))
+			}
+			fmt.Fprintf(w, `{"status":"success","data":{"resultType":"streams","result":[%v]}}`, strings.Join(result, ","))
+		case "/prometheus/api/v1/query_range":
+			fmt.Fprint(w, `{"status":"success","data":{"resultType":"matrix","result":[`+
+				`{"metric":{"__name__":"up"},"values":[[1,"1"]]}]}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	var endpoints config.Endpoints
+	endpoints.Loki.Read = server.URL
+	endpoints.Mimir.Query = server.URL
+
+	var cfg config.Archive
+	cfg.Lookback = time.Hour
+	cfg.Loki.Limit = 3
+
+	dir := t.TempDir()
+	sink, err := NewLocalSink(dir)
+	require.NoError(t, err)
+	a, err := New(cfg, endpoints, sink)
+	require.NoError(t, err)
+
+	var checkpoints []Progress
+	location, err := a.Archive(t.Context(), tenantID, Progress{}, func(p Progress) {
+		checkpoints = append(checkpoints, p)
+	})
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(location, filepath.Join(dir, tenantID)), "Archive location different from expected")
+	require.Len(t, checkpoints, 1)
+	require.Equal(t, 1, checkpoints[0].Windows)
+
+	windows, err := filepath.Glob(filepath.Join(location, "*"))
+	require.NoError(t, err)
+	require.Len(t, windows, 1)
+
+	logs := strings.Join(readLines(t, filepath.Join(windows[0], logsObject)), "\n")
+	for _, e := range entries {
+		require.Equal(t, 1, strings.Count(logs, `"`+e.line+`"`), "Log entry %q not archived exactly once", e.line)
+	}
+
+	metrics := readLines(t, filepath.Join(windows[0], metricsObject))
+	require.Len(t, metrics, 1)
+	require.Contains(t, metrics[0], `"__name__":"up"`)
+}
+
+func TestArchiveFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"status":"error"}`)
+	}))
+	defer server.Close()
+
+	var endpoints config.Endpoints
+	endpoints.Loki.Read = server.URL
+	endpoints.Mimir.Query = server.URL
+
+	var cfg config.Archive
+	cfg.Lookback = time.Hour
+
+	sink, err := NewLocalSink(t.TempDir())
+	require.NoError(t, err)
+	a, err := New(cfg, endpoints, sink)
+	require.NoError(t, err)
+
+	_, err = a.Archive(t.Context(), tenantID, Progress{}, func(Progress) {
+		t.Error("Failed window checkpointed")
+	})
+	require.ErrorContains(t, err, "failed to archive logs")
+}
+
+func TestArchiveResume(t *testing.T) {
+	var starts []int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/loki/api/v1/query_range":
+			fmt.Fprint(w, `{"status":"success","data":{"resultType":"streams","result":[]}}`)
+		case "/prometheus/api/v1/query_range":
+			start, err := strconv.ParseInt(r.URL.Query().Get("start"), 10, 64)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			starts = append(starts, start)
+			fmt.Fprint(w, `{"status":"success","data":{"resultType":"matrix","result":[]}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	var endpoints config.Endpoints
+	endpoints.Loki.Read = server.URL
+	endpoints.Mimir.Query = server.URL
+
+	var cfg config.Archive
+	cfg.Lookback = 3 * time.Hour
+	cfg.Window = time.Hour
+
+	dir := t.TempDir()
+	sink, err := NewLocalSink(dir)
+	require.NoError(t, err)
+	a, err := New(cfg, endpoints, sink)
+	require.NoError(t, err)
+
+	to := time.Date(2025, time.January, 2, 3, 0, 0, 0, time.UTC)
+	var checkpoints []int
+	location, err := a.Archive(t.Context(), tenantID, Progress{To: to, Windows: 1}, func(p Progress) {
+		require.Equal(t, to, p.To, "Archive range changed on resume")
+		checkpoints = append(checkpoints, p.Windows)
+	})
+	require.NoError(t, err)
+	require.Equal(t, filepath.Join(dir, tenantID, "20250102T030000Z"), location, "Resumed archive location different from expected")
+	require.Equal(t, []int{2, 3}, checkpoints)
+	require.Equal(t, []int64{to.Add(-2 * time.Hour).Unix(), to.Add(-time.Hour).Unix()}, starts, "Completed window exported again")
+
+	windows, err := filepath.Glob(filepath.Join(location, "*", metricsObject))
+	require.NoError(t, err)
+	require.Len(t, windows, 2)
+}
+
+func TestS3Sink(t *testing.T) {
+	t.Setenv(envAccessKeyID, "access")
+	t.Setenv(envSecretAccessKey, "secret")
+
+	const body = "archived data"
+	bodyHash := sha256.Sum256([]byte(body))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		if err != nil || string(data) != body {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if r.Method != http.MethodPut || r.URL.Path != "/bucket/prefix/tenant/logs.jsonl" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("X-Amz-Content-Sha256") != hex.EncodeToString(bodyHash[:]) ||
+			!strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+	}))
+	defer server.Close()
+
+	sink, err := NewS3Sink(server.URL, "", "bucket", "/prefix/")
+	require.NoError(t, err)
+	require.NoError(t, sink.Put(t.Context(), "tenant/logs.jsonl", strings.NewReader(body)))
+	require.Equal(t, "s3://bucket/prefix/tenant/logs.jsonl", sink.Location("tenant/logs.jsonl"))
+
+	require.Error(t, sink.Put(t.Context(), "other", strings.NewReader(body)), "Function doesn't return an error")
+}
+
+func readLines(t *testing.T, name string) []string {
+	t.Helper()
+
+	f, err := os.Open(name)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	require.NoError(t, scanner.Err())
+	return lines
+}
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package archive
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/util"
+)
+
+const (
+	envAccessKeyID     = "AWS_ACCESS_KEY_ID"
+	envSecretAccessKey = "AWS_SECRET_ACCESS_KEY"
+
+	amzDateFormat = "20060102T150405Z"
+)
+
+// S3Sink stores objects in a bucket of S3-compatible object storage. Requests are signed with AWS Signature Version 4
+// and use path-style addressing, which is supported by S3 as well as by self-hosted implementations.
+type S3Sink struct {
+	endpoint        *url.URL
+	region          string
+	bucket          string
+	prefix          string
+	accessKeyID     string
+	secretAccessKey string
+	client          *http.Client
+}
+
+// NewS3Sink creates S3 sink. Credentials are read from AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables.
+func NewS3Sink(endpoint, region, bucket, prefix string) (*S3Sink, error) {
+	if endpoint == "" || bucket == "" {
+		return nil, errors.New("s3 sink endpoint and bucket cannot be empty")
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse s3 endpoint: %w", err)
+	}
+
+	accessKeyID, secretAccessKey := os.Getenv(envAccessKeyID), os.Getenv(envSecretAccessKey)
+	if accessKeyID == "" || secretAccessKey == "" {
+		return nil, fmt.Errorf("s3 sink requires %v and %v environment variables", envAccessKeyID, envSecretAccessKey)
+	}
+
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	return &S3Sink{
+		endpoint:        u,
+		region:          region,
+		bucket:          bucket,
+		prefix:          strings.Trim(prefix, "/"),
+		accessKeyID:     accessKeyID,
+		secretAccessKey: secretAccessKey,
+		client:          utility.HTTPClient,
+	}, nil
+}
+
+func (s *S3Sink) Put(ctx context.Context, key string, body io.ReadSeeker) error {
+	hash := sha256.New()
+	size, err := io.Copy(hash, body)
+	if err != nil {
+		return fmt.Errorf("failed to hash object %q: %w", key, err)
+	}
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to rewind object %q: %w", key, err)
+	}
+
+	u := *s.endpoint
+	u.Path = path.Join("/", u.Path, s.bucket, s.objectKey(key))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u.String(), io.NopCloser(body))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.ContentLength = size
+
+	s.sign(req, hex.EncodeToString(hash.Sum(nil)), time.Now())
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach endpoint %v: %w", s.endpoint, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("invalid response status code '%v' for object: %v", res.StatusCode, s.Location(key))
+	}
+	return nil
+}
+
+func (s *S3Sink) Location(key string) string {
+	return fmt.Sprintf("s3://%v/%v", s.bucket, s.objectKey(key))
+}
+
+func (s *S3Sink) objectKey(key string) string {
+	if s.prefix == "" {
+		return key
+	}
+	return s.prefix + "/" + key
+}
+
+// sign adds AWS Signature Version 4 authorization to the request.
+func (s *S3Sink) sign(req *http.Request, payloadHash string, now time.Time) {
+	amzDate := now.UTC().Format(amzDateFormat)
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	const signedHeaders = "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		fmt.Sprintf("host:%v\nx-amz-content-sha256:%v\nx-amz-date:%v\n", req.URL.Host, payloadHash, amzDate),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := fmt.Sprintf("%v/%v/s3/aws4_request", date, s.region)
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, hex.EncodeToString(canonicalHash[:])}, "\n")
+
+	key := []byte("AWS4" + s.secretAccessKey)
+	for _, part := range []string{date, s.region, "s3", "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%v/%v, SignedHeaders=%v, Signature=%v",
+		s.accessKeyID, scope, signedHeaders, signature))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package archive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+)
+
+// Sink stores archived objects. Keys are slash-separated paths relative to the sink root.
+type Sink interface {
+	Put(ctx context.Context, key string, body io.ReadSeeker) error
+	// Location returns human-readable location of the key, e.g. a path or an URL.
+	Location(key string) string
+}
+
+// NewSink creates a sink of the configured type.
+func NewSink(cfg config.Sink) (Sink, error) {
+	switch cfg.Type {
+	case "", config.SinkLocal:
+		return NewLocalSink(cfg.Path)
+	case config.SinkS3:
+		return NewS3Sink(cfg.S3.Endpoint, cfg.S3.Region, cfg.S3.Bucket, cfg.S3.Prefix)
+	default:
+		return nil, fmt.Errorf("unknown archive sink type %q", cfg.Type)
+	}
+}
+
+// LocalSink stores objects as files in a local directory, e.g. a mounted persistent volume.
+type LocalSink struct {
+	dir string
+}
+
+func NewLocalSink(dir string) (*LocalSink, error) {
+	if dir == "" {
+		return nil, errors.New("local sink path cannot be empty")
+	}
+	return &LocalSink{dir: dir}, nil
+}
+
+func (s *LocalSink) Put(_ context.Context, key string, body io.ReadSeeker) error {
+	name := filepath.Join(s.dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(name), 0o750); err != nil {
+		return fmt.Errorf("failed to create directory for %q: %w", name, err)
+	}
+
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to create %q: %w", name, err)
+	}
+
+	if _, err := io.Copy(f, body); err != nil {
+		f.Close()
+		return fmt.Errorf("failed to write %q: %w", name, err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to write %q: %w", name, err)
+	}
+	return nil
+}
+
+func (s *LocalSink) Location(key string) string {
+	return filepath.Join(s.dir, filepath.FromSlash(key))
+}
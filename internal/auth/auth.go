@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
+)
+
+// AllOrgs in the orgs claim grants access to projects of all orgs.
+const AllOrgs = "*"
+
+var authFailures = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "grpc_auth_failures_total",
+		Help: "Number of gRPC calls rejected for missing or invalid bearer token",
+	}, []string{"method"},
+)
+
+// signingMethods are accepted token signature algorithms. Symmetric ones are excluded, as keys are public.
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// Grant lists orgs whose projects the caller may receive.
+type Grant struct {
+	all  bool
+	orgs map[string]struct{}
+}
+
+// Allows reports whether projects of the org may be sent to the caller.
+func (g Grant) Allows(org string) bool {
+	if g.all {
+		return true
+	}
+	_, ok := g.orgs[org]
+	return ok
+}
+
+// NewGrant returns grant of the orgs, AllOrgs among them grants all orgs.
+func NewGrant(orgs ...string) Grant {
+	g := Grant{orgs: make(map[string]struct{}, len(orgs))}
+	for _, org := range orgs {
+		if org == AllOrgs {
+			g.all = true
+		}
+		g.orgs[org] = struct{}{}
+	}
+	return g
+}
+
+type grantKey struct{}
+
+// NewContext returns context carrying the grant.
+func NewContext(ctx context.Context, g Grant) context.Context {
+	return context.WithValue(ctx, grantKey{}, g)
+}
+
+// FromContext returns grant of the authenticated caller. Calls not subject to authentication, e.g. when it is
+// disabled, are granted all orgs.
+func FromContext(ctx context.Context) Grant {
+	if g, ok := ctx.Value(grantKey{}).(Grant); ok {
+		return g
+	}
+	return Grant{all: true}
+}
+
+// Authenticator verifies bearer JWTs of gRPC calls and grants callers orgs listed in the configured claim.
+type Authenticator struct {
+	cfg    config.Auth
+	keys   *keySource
+	parser *jwt.Parser
+}
+
+// New creates authenticator. Keys are loaded on the first call.
+func New(cfg config.Auth) *Authenticator {
+	opts := []jwt.ParserOption{jwt.WithValidMethods(signingMethods), jwt.WithExpirationRequired()}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.WithAudience(cfg.Audience))
+	}
+	return &Authenticator{
+		cfg:    cfg,
+		keys:   &keySource{file: cfg.JWKSFile, url: cfg.JWKSURL, refresh: cfg.JWKSRefresh},
+		parser: jwt.NewParser(opts...),
+	}
+}
+
+// Authenticate verifies the bearer token of the incoming call and returns orgs it grants.
+func (a *Authenticator) Authenticate(ctx context.Context) (Grant, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return Grant{}, errors.New("missing bearer token")
+	}
+	scheme, raw, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") {
+		return Grant{}, errors.New("authorization is not a bearer token")
+	}
+
+	claims := jwt.MapClaims{}
+	_, err := a.parser.ParseWithClaims(raw, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		return a.keys.key(ctx, kid)
+	})
+	if err != nil {
+		return Grant{}, fmt.Errorf("invalid token: %w", err)
+	}
+	return a.grant(claims), nil
+}
+
+// grant reads orgs out of the claim holding a single org or a list of them.
+func (a *Authenticator) grant(claims jwt.MapClaims) Grant {
+	var orgs []string
+	switch v := claims[a.cfg.OrgsClaim].(type) {
+	case string:
+		orgs = []string{v}
+	case []any:
+		for _, item := range v {
+			if org, ok := item.(string); ok {
+				orgs = append(orgs, org)
+			}
+		}
+	}
+	return NewGrant(orgs...)
+}
+
+// authenticate attaches grant of the caller to the context, rejecting calls without a valid token. Only methods
+// of the given services are authenticated.
+func (a *Authenticator) authenticate(ctx context.Context, method string, services []string) (context.Context, error) {
+	if !a.cfg.Enabled || !protected(method, services) {
+		return ctx, nil
+	}
+	g, err := a.Authenticate(ctx)
+	if err != nil {
+		authFailures.WithLabelValues(method).Inc()
+		slog.Warn("Rejected unauthenticated call", "method", method, logging.Err(err))
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return NewContext(ctx, g), nil
+}
+
+// UnaryInterceptor authenticates unary calls of the given services.
+func (a *Authenticator) UnaryInterceptor(services ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.authenticate(ctx, info.FullMethod, services)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor authenticates streaming calls of the given services.
+func (a *Authenticator) StreamInterceptor(services ...string) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := a.authenticate(ss.Context(), info.FullMethod, services)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func protected(method string, services []string) bool {
+	for _, service := range services {
+		if strings.HasPrefix(method, "/"+service+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// serverStream carries the context with grant of the caller.
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,278 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+)
+
+const service = "projectstream.ProjectService"
+
+type signer struct {
+	kid string
+	key *ecdsa.PrivateKey
+}
+
+func newSigner(t *testing.T, kid string) signer {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return signer{kid: kid, key: key}
+}
+
+func (s signer) token(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	if _, ok := claims["exp"]; !ok {
+		claims["exp"] = time.Now().Add(time.Hour).Unix()
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["kid"] = s.kid
+	signed, err := token.SignedString(s.key)
+	require.NoError(t, err)
+	return signed
+}
+
+func keySet(t *testing.T, signers ...signer) []byte {
+	t.Helper()
+	keys := make([]jwk, 0, len(signers))
+	for _, s := range signers {
+		point, err := s.key.PublicKey.Bytes()
+		require.NoError(t, err)
+		keys = append(keys, jwk{
+			Kty: "EC",
+			Kid: s.kid,
+			Use: "sig",
+			Crv: "P-256",
+			X:   base64.RawURLEncoding.EncodeToString(point[1:33]),
+			Y:   base64.RawURLEncoding.EncodeToString(point[33:]),
+		})
+	}
+	data, err := json.Marshal(map[string]any{"keys": keys})
+	require.NoError(t, err)
+	return data
+}
+
+func TestParseKeySet(t *testing.T) {
+	first := newSigner(t, "first")
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	require.NoError(t, json.Unmarshal(keySet(t, first), &set))
+	okp := jwk{Kty: "OKP", Kid: "ed25519", Use: "sig", Crv: "Ed25519", X: "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo"}
+	brainpool := jwk{Kty: "EC", Kid: "brainpool", Use: "sig", Crv: "BP-256"}
+
+	data, err := json.Marshal(map[string]any{"keys": append([]jwk{okp, brainpool}, set.Keys...)})
+	require.NoError(t, err)
+	keys, err := parseKeySet(data)
+	require.NoError(t, err, "Key set rejected due to unsupported keys")
+	require.Len(t, keys, 1)
+	require.Contains(t, keys, "first")
+
+	data, err = json.Marshal(map[string]any{"keys": []jwk{okp, brainpool}})
+	require.NoError(t, err)
+	_, err = parseKeySet(data)
+	require.ErrorContains(t, err, "no usable signing keys")
+}
+
+func withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestAuthenticate(t *testing.T) {
+	s := newSigner(t, "first")
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, keySet(t, s), 0o600))
+	a := New(config.Auth{Enabled: true, JWKSFile: path, Issuer: "keycloak", Audience: "tenant-controller", OrgsClaim: "orgs"})
+
+	t.Run("Orgs granted", func(t *testing.T) {
+		g, err := a.Authenticate(withToken(s.token(t, jwt.MapClaims{
+			"iss": "keycloak", "aud": "tenant-controller", "orgs": []string{"first-org", "second-org"},
+		})))
+		require.NoError(t, err)
+		require.True(t, g.Allows("first-org"))
+		require.True(t, g.Allows("second-org"))
+		require.False(t, g.Allows("third-org"))
+	})
+
+	t.Run("All orgs granted", func(t *testing.T) {
+		g, err := a.Authenticate(withToken(s.token(t, jwt.MapClaims{"iss": "keycloak", "aud": "tenant-controller", "orgs": AllOrgs})))
+		require.NoError(t, err)
+		require.True(t, g.Allows("any-org"))
+	})
+
+	t.Run("No orgs granted", func(t *testing.T) {
+		g, err := a.Authenticate(withToken(s.token(t, jwt.MapClaims{"iss": "keycloak", "aud": "tenant-controller"})))
+		require.NoError(t, err)
+		require.False(t, g.Allows("first-org"))
+	})
+
+	tests := map[string]context.Context{
+		"Missing token":   context.Background(),
+		"Not a bearer":    metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Basic Zm9vOmJhcg==")),
+		"Malformed token": withToken("not-a-token"),
+		"Expired token": withToken(s.token(t, jwt.MapClaims{
+			"iss": "keycloak", "aud": "tenant-controller", "exp": time.Now().Add(-time.Minute).Unix(),
+		})),
+		"Wrong issuer":   withToken(s.token(t, jwt.MapClaims{"iss": "other", "aud": "tenant-controller"})),
+		"Wrong audience": withToken(s.token(t, jwt.MapClaims{"iss": "keycloak", "aud": "other"})),
+		"Unknown key":    withToken(newSigner(t, "other").token(t, jwt.MapClaims{"iss": "keycloak", "aud": "tenant-controller"})),
+		"Forged key":     withToken(newSigner(t, "first").token(t, jwt.MapClaims{"iss": "keycloak", "aud": "tenant-controller"})),
+	}
+	for name, ctx := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := a.Authenticate(ctx)
+			require.Error(t, err)
+		})
+	}
+
+	t.Run("Rotated keys", func(t *testing.T) {
+		rotated := newSigner(t, "second")
+		require.NoError(t, os.WriteFile(path, keySet(t, rotated), 0o600))
+		later := time.Now().Add(time.Minute)
+		require.NoError(t, os.Chtimes(path, later, later))
+
+		_, err := a.Authenticate(withToken(rotated.token(t, jwt.MapClaims{"iss": "keycloak", "aud": "tenant-controller"})))
+		require.NoError(t, err)
+		_, err = a.Authenticate(withToken(s.token(t, jwt.MapClaims{"iss": "keycloak", "aud": "tenant-controller"})))
+		require.Error(t, err, "Removed key still accepted")
+	})
+}
+
+func TestKeysURL(t *testing.T) {
+	first, second := newSigner(t, "first"), newSigner(t, "second")
+	var fetches atomic.Int32
+	var data atomic.Value
+	data.Store(keySet(t, first))
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fetches.Add(1)
+		_, _ = w.Write(data.Load().([]byte))
+	}))
+	defer srv.Close()
+
+	a := New(config.Auth{Enabled: true, JWKSURL: srv.URL, JWKSRefresh: time.Hour, OrgsClaim: "orgs"})
+	_, err := a.Authenticate(withToken(first.token(t, jwt.MapClaims{})))
+	require.NoError(t, err)
+	_, err = a.Authenticate(withToken(first.token(t, jwt.MapClaims{})))
+	require.NoError(t, err)
+	require.Equal(t, int32(1), fetches.Load(), "Keys fetched before refresh period")
+
+	// Unknown key does not trigger fetching within the minimal interval.
+	data.Store(keySet(t, first, second))
+	_, err = a.Authenticate(withToken(second.token(t, jwt.MapClaims{})))
+	require.Error(t, err)
+	require.Equal(t, int32(1), fetches.Load())
+
+	a.keys.fetched = time.Now().Add(-minFetchInterval)
+	_, err = a.Authenticate(withToken(second.token(t, jwt.MapClaims{})))
+	require.NoError(t, err, "Keys not fetched for unknown key")
+	require.Equal(t, int32(2), fetches.Load())
+}
+
+func TestKeysURLSlowFetch(t *testing.T) {
+	first := newSigner(t, "first")
+	var fetches atomic.Int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if fetches.Add(1) > 1 {
+			<-release
+		}
+		_, _ = w.Write(keySet(t, first))
+	}))
+	defer srv.Close()
+
+	a := New(config.Auth{Enabled: true, JWKSURL: srv.URL, JWKSRefresh: time.Hour, OrgsClaim: "orgs"})
+	_, err := a.Authenticate(withToken(first.token(t, jwt.MapClaims{})))
+	require.NoError(t, err)
+
+	a.keys.mu.Lock()
+	a.keys.fetched = time.Now().Add(-time.Hour)
+	a.keys.mu.Unlock()
+	refreshed := make(chan error)
+	go func() {
+		_, err := a.Authenticate(withToken(first.token(t, jwt.MapClaims{})))
+		refreshed <- err
+	}()
+	require.Eventually(t, func() bool { return fetches.Load() == 2 }, time.Second, 10*time.Millisecond, "Refresh not started")
+
+	// Calls with known keys neither wait for the refresh in progress nor start another one.
+	_, err = a.Authenticate(withToken(first.token(t, jwt.MapClaims{})))
+	require.NoError(t, err)
+	require.Equal(t, int32(2), fetches.Load())
+
+	close(release)
+	require.NoError(t, <-refreshed)
+}
+
+type fakeStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	s := newSigner(t, "first")
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, keySet(t, s), 0o600))
+	a := New(config.Auth{Enabled: true, JWKSFile: path, OrgsClaim: "orgs"})
+	interceptor := a.StreamInterceptor(service)
+
+	var grant Grant
+	handler := func(_ any, stream grpc.ServerStream) error {
+		grant = FromContext(stream.Context())
+		return nil
+	}
+
+	t.Run("Authenticated call carries grant", func(t *testing.T) {
+		stream := &fakeStream{ctx: withToken(s.token(t, jwt.MapClaims{"orgs": "first-org"}))}
+		require.NoError(t, interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/" + service + "/StreamProjectUpdates"}, handler))
+		require.True(t, grant.Allows("first-org"))
+		require.False(t, grant.Allows("second-org"))
+	})
+
+	t.Run("Unauthenticated call rejected", func(t *testing.T) {
+		failuresBefore := testutil.ToFloat64(authFailures.WithLabelValues("/" + service + "/StreamProjectUpdates"))
+		err := interceptor(nil, &fakeStream{ctx: context.Background()},
+			&grpc.StreamServerInfo{FullMethod: "/" + service + "/StreamProjectUpdates"}, handler)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+		require.InDelta(t, failuresBefore+1, testutil.ToFloat64(authFailures.WithLabelValues("/"+service+"/StreamProjectUpdates")), 0)
+	})
+
+	t.Run("Other services not authenticated", func(t *testing.T) {
+		stream := &fakeStream{ctx: context.Background()}
+		require.NoError(t, interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/grpc.health.v1.Health/Watch"}, handler))
+		require.True(t, grant.Allows("any-org"))
+	})
+
+	t.Run("Disabled authentication grants all orgs", func(t *testing.T) {
+		disabled := New(config.Auth{OrgsClaim: "orgs"}).StreamInterceptor(service)
+		stream := &fakeStream{ctx: context.Background()}
+		require.NoError(t, disabled(nil, stream, &grpc.StreamServerInfo{FullMethod: "/" + service + "/StreamProjectUpdates"}, handler))
+		require.True(t, grant.Allows("any-org"))
+	})
+}
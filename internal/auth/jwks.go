@@ -0,0 +1,247 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/big"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/util"
+)
+
+// minFetchInterval limits fetching of keys triggered by tokens signed with unknown keys.
+const minFetchInterval = 10 * time.Second
+
+// fetchTimeout bounds a single fetch of keys.
+const fetchTimeout = 10 * time.Second
+
+// jwk is a JSON Web Key of RSA or EC type.
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// errUnsupportedKey is returned for keys of types and curves tokens cannot be verified with.
+var errUnsupportedKey = errors.New("unsupported key")
+
+// parseKeySet returns public keys of the JSON Web Key Set by their IDs. Keys not used for signatures and keys of
+// unsupported types are skipped, so the set is rejected only when none of its keys can verify tokens.
+func parseKeySet(data []byte) (map[string]crypto.PublicKey, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal key set: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if errors.Is(err, errUnsupportedKey) {
+			slog.Debug("Skipping token verification key", "kid", k.Kid, logging.Err(err))
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %q: %w", k.Kid, err)
+		}
+		keys[k.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("key set contains no usable signing keys")
+	}
+	return keys, nil
+}
+
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		if !e.IsInt64() {
+			return nil, errors.New("exponent too large")
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("%w: curve %q", errUnsupportedKey, k.Crv)
+		}
+		// Coordinates are padded to the size of the curve, forming uncompressed point together.
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode: %w", err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode: %w", err)
+		}
+		return ecdsa.ParseUncompressedPublicKey(curve, append(append([]byte{4}, x...), y...))
+	default:
+		return nil, fmt.Errorf("%w: type %q", errUnsupportedKey, k.Kty)
+	}
+}
+
+func decodeInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode: %w", err)
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+// keySource reads keys from a file, reading it again when modified, or from a URL, fetching them every refresh
+// period and when a token is signed with an unknown key.
+type keySource struct {
+	file    string
+	url     string
+	refresh time.Duration
+
+	mu      sync.Mutex
+	keys    map[string]crypto.PublicKey
+	modTime time.Time
+	fetched time.Time
+	// fetching is closed when the fetch in progress completes, nil when no fetch is in progress.
+	fetching chan struct{}
+}
+
+// key returns public key with the given ID. Keys failing to load are reported and the previous ones are kept.
+func (s *keySource) key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	if s.file != "" {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if err := s.readFile(); err != nil {
+			slog.Error("Failed to load token verification keys - using the previous ones", logging.Err(err))
+		}
+		return s.lookup(kid)
+	}
+
+	s.mu.Lock()
+	_, ok := s.keys[kid]
+	due := time.Since(s.fetched) >= s.refresh || (!ok && time.Since(s.fetched) >= minFetchInterval)
+	switch {
+	case s.fetching == nil && due:
+		s.fetchKeys(ctx)
+	case s.fetching != nil && !ok:
+		// Key may come with the keys being fetched, callers with known keys do not wait for them.
+		done := s.fetching
+		s.mu.Unlock()
+		select {
+		case <-done:
+		case <-ctx.Done():
+		}
+		s.mu.Lock()
+	}
+	defer s.mu.Unlock()
+	return s.lookup(kid)
+}
+
+func (s *keySource) lookup(kid string) (crypto.PublicKey, error) {
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", kid)
+	}
+	return key, nil
+}
+
+// fetchKeys fetches keys without holding the lock, which must be held when called and is held again on return. Calls
+// made meanwhile do not start another fetch.
+func (s *keySource) fetchKeys(ctx context.Context) {
+	// Failed fetch is not retried before the minimal interval either.
+	s.fetched = time.Now()
+	done := make(chan struct{})
+	s.fetching = done
+	s.mu.Unlock()
+
+	// Fetch is shared by concurrent calls, so it is not cancelled with the call starting it.
+	keys, err := s.fetch(context.WithoutCancel(ctx))
+
+	s.mu.Lock()
+	if err != nil {
+		slog.Error("Failed to load token verification keys - using the previous ones", logging.Err(err))
+	} else {
+		s.keys = keys
+	}
+	s.fetching = nil
+	close(done)
+}
+
+func (s *keySource) readFile() error {
+	info, err := os.Stat(s.file)
+	if err != nil {
+		return fmt.Errorf("failed to stat %q: %w", s.file, err)
+	}
+	if s.keys != nil && info.ModTime().Equal(s.modTime) {
+		return nil
+	}
+	data, err := os.ReadFile(s.file)
+	if err != nil {
+		return fmt.Errorf("failed to read file %q: %w", s.file, err)
+	}
+	keys, err := parseKeySet(data)
+	if err != nil {
+		return err
+	}
+	s.keys, s.modTime = keys, info.ModTime()
+	return nil
+}
+
+func (s *keySource) fetch(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	res, err := utility.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach endpoint %v: %w", s.url, err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("invalid response status code '%v' for endpoint: %v", res.StatusCode, s.url)
+	}
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+	return parseKeySet(data)
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/util"
+)
+
+// TenantBackend is a component reconfigured by the tenant controller upon tenant creation and removal.
+// Tenant ID is passed to every method within context under utility.ContextKeyTenantID key.
+type TenantBackend interface {
+	// Name returns the backend name as used in the configuration.
+	Name() string
+	// Initialize creates tenant in the backend. It must succeed when tenant already exists.
+	Initialize(ctx context.Context) error
+	// Cleanup removes tenant and all of its data from the backend. It must succeed when tenant is already removed.
+	Cleanup(ctx context.Context) error
+	// Verify checks whether the backend is reachable and able to serve tenant requests.
+	Verify(ctx context.Context) error
+}
+
+// ErrListingUnsupported is returned by TenantLister that cannot list tenants in the current configuration.
+var ErrListingUnsupported = errors.New("tenant listing unsupported")
+
+// TenantLister is implemented by backends able to list IDs of tenants they hold data of.
+type TenantLister interface {
+	TenantBackend
+	ListTenants(ctx context.Context) ([]string, error)
+}
+
+// Reconfigurable is implemented by backends able to apply changed endpoints configuration without restart.
+type Reconfigurable interface {
+	Reconfigure(endpoints config.Endpoints)
+}
+
+// Dependencies are shared resources passed to backend factories.
+type Dependencies struct {
+	Endpoints config.Endpoints
+	AmConn    *Conn
+	SreConn   *Conn
+}
+
+// Factory creates a backend out of the shared dependencies.
+type Factory func(deps Dependencies) TenantBackend
+
+// Names returns the names of enabled backends in configured order.
+// When no backends are configured explicitly, all known backends are enabled and sre-exporter depends on job.sre.enabled setting.
+func Names(cfg config.Config) []string {
+	if len(cfg.Endpoints.Backends) != 0 {
+		return cfg.Endpoints.Backends
+	}
+
+	names := []string{config.BackendAlertingMonitor}
+	if cfg.Job.Sre.Enabled {
+		names = append(names, config.BackendSre)
+	}
+	return append(names, config.BackendLoki, config.BackendMimir)
+}
+
+// NewRegistry creates backends with given names using known factories, preserving order of names.
+func NewRegistry(factories map[string]Factory, deps Dependencies, names []string) ([]TenantBackend, error) {
+	backends := make([]TenantBackend, 0, len(names))
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		factory, ok := factories[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown backend %q", name)
+		}
+		if _, ok := seen[name]; ok {
+			return nil, fmt.Errorf("backend %q configured more than once", name)
+		}
+		seen[name] = struct{}{}
+		backends = append(backends, factory(deps))
+	}
+	return backends, nil
+}
+
+// VerifyConn checks whether gRPC connection is usable. Idle connection is requested to connect.
+func VerifyConn(conn *grpc.ClientConn, name string) error {
+	if conn == nil {
+		return fmt.Errorf("%v connection is not configured", name)
+	}
+
+	state := conn.GetState()
+	switch state {
+	case connectivity.Idle:
+		conn.Connect()
+	case connectivity.TransientFailure, connectivity.Shutdown:
+		return fmt.Errorf("%v connection is in %v state", name, state)
+	case connectivity.Connecting, connectivity.Ready:
+	}
+	return nil
+}
+
+// VerifyReady checks whether all given HTTP components report readiness on their /ready endpoint.
+func VerifyReady(ctx context.Context, urls ...string) error {
+	for _, u := range urls {
+		if _, err := utility.GetReq(ctx, fmt.Sprintf("%v/ready", u), ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListTenants reads tenant IDs from an endpoint responding with JSON object holding tenants array,
+// e.g. Mimir store-gateway /store-gateway/tenants.
+func ListTenants(ctx context.Context, url string) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	// Admin pages of Grafana components are rendered as HTML unless JSON is requested explicitly.
+	req.Header.Set("Accept", "application/json")
+
+	res, err := utility.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reach endpoint %v: %w", url, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("invalid response status code '%v' for endpoint: %v", res.StatusCode, url)
+	}
+
+	var body struct {
+		Tenants []string `json:"tenants"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal response body: %w", err)
+	}
+	return body.Tenants, nil
+}
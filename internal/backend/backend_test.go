@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+)
+
+func TestNames(t *testing.T) {
+	t.Run("Default backends with sre enabled", func(t *testing.T) {
+		var cfg config.Config
+		cfg.Job.Sre.Enabled = true
+		require.Equal(t, []string{config.BackendAlertingMonitor, config.BackendSre, config.BackendLoki, config.BackendMimir}, Names(cfg))
+	})
+
+	t.Run("Default backends with sre disabled", func(t *testing.T) {
+		var cfg config.Config
+		require.Equal(t, []string{config.BackendAlertingMonitor, config.BackendLoki, config.BackendMimir}, Names(cfg))
+	})
+
+	t.Run("Explicitly configured backends", func(t *testing.T) {
+		var cfg config.Config
+		cfg.Endpoints.Backends = []string{config.BackendMimir, config.BackendLoki}
+		require.Equal(t, []string{config.BackendMimir, config.BackendLoki}, Names(cfg))
+	})
+}
+
+type namedBackend struct {
+	name string
+}
+
+func (b *namedBackend) Name() string {
+	return b.name
+}
+
+func (*namedBackend) Initialize(_ context.Context) error {
+	return nil
+}
+
+func (*namedBackend) Cleanup(_ context.Context) error {
+	return nil
+}
+
+func (*namedBackend) Verify(_ context.Context) error {
+	return nil
+}
+
+func TestNewRegistry(t *testing.T) {
+	factories := map[string]Factory{}
+	for _, name := range []string{config.BackendAlertingMonitor, config.BackendSre, config.BackendLoki, config.BackendMimir} {
+		factories[name] = func(_ Dependencies) TenantBackend { return &namedBackend{name: name} }
+	}
+
+	t.Run("Backends created in configured order", func(t *testing.T) {
+		backends, err := NewRegistry(factories, Dependencies{}, []string{config.BackendMimir, config.BackendAlertingMonitor, config.BackendLoki, config.BackendSre})
+		require.NoError(t, err)
+		require.Len(t, backends, 4)
+
+		names := make([]string, 0, len(backends))
+		for _, b := range backends {
+			names = append(names, b.Name())
+		}
+		require.Equal(t, []string{config.BackendMimir, config.BackendAlertingMonitor, config.BackendLoki, config.BackendSre}, names)
+	})
+
+	t.Run("Unknown backend - error expected", func(t *testing.T) {
+		_, err := NewRegistry(factories, Dependencies{}, []string{config.BackendLoki, "foo"})
+		require.ErrorContains(t, err, `unknown backend "foo"`)
+	})
+
+	t.Run("Duplicated backend - error expected", func(t *testing.T) {
+		_, err := NewRegistry(factories, Dependencies{}, []string{config.BackendLoki, config.BackendLoki})
+		require.ErrorContains(t, err, `backend "loki" configured more than once`)
+	})
+}
+
+func TestVerifyReady(t *testing.T) {
+	tests := map[string]struct {
+		errorReturned bool
+		readyHTTPCode int
+	}{
+		"Component ready - no error expected": {
+			errorReturned: false,
+			readyHTTPCode: http.StatusOK,
+		},
+		"Component not ready - error expected": {
+			errorReturned: true,
+			readyHTTPCode: http.StatusServiceUnavailable,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			svr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/ready" {
+					w.WriteHeader(test.readyHTTPCode)
+				}
+			}))
+			defer svr.Close()
+
+			if test.errorReturned {
+				require.Error(t, VerifyReady(t.Context(), svr.URL, svr.URL), "Function doesn't return an error")
+			} else {
+				require.NoError(t, VerifyReady(t.Context(), svr.URL, svr.URL), "Function returned an error")
+			}
+		})
+	}
+}
+
+func TestVerifyConn(t *testing.T) {
+	t.Run("Missing connection - error expected", func(t *testing.T) {
+		require.ErrorContains(t, VerifyConn(nil, config.BackendSre), "sre connection is not configured")
+	})
+
+	t.Run("Idle connection - no error expected", func(t *testing.T) {
+		conn, err := grpc.NewClient("localhost:0", grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+		defer conn.Close()
+
+		require.NoError(t, VerifyConn(conn, config.BackendSre))
+	})
+
+	t.Run("Closed connection - error expected", func(t *testing.T) {
+		conn, err := grpc.NewClient("localhost:0", grpc.WithTransportCredentials(insecure.NewCredentials()))
+		require.NoError(t, err)
+		require.NoError(t, conn.Close())
+
+		require.ErrorContains(t, VerifyConn(conn, config.BackendSre), "SHUTDOWN")
+	})
+}
+
+func TestListTenants(t *testing.T) {
+	t.Run("Tenants listed", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Accept") != "application/json" {
+				_, _ = w.Write([]byte("<html></html>"))
+				return
+			}
+			_, _ = w.Write([]byte(`{"now":"2025-01-01T00:00:00Z","tenants":["first","second"]}`))
+		}))
+		defer srv.Close()
+
+		tenants, err := ListTenants(t.Context(), srv.URL)
+		require.NoError(t, err)
+		require.Equal(t, []string{"first", "second"}, tenants)
+	})
+
+	t.Run("Invalid status code - error expected", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		_, err := ListTenants(t.Context(), srv.URL)
+		require.ErrorContains(t, err, "invalid response status code")
+	})
+}
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/tlsconfig"
+)
+
+// DialFunc creates gRPC client connection to the address secured by the TLS settings.
+type DialFunc func(address string, tlsCfg config.TLS) (*grpc.ClientConn, error)
+
+// Dial creates traced gRPC client connection to the address secured by the TLS settings.
+func Dial(address string, tlsCfg config.TLS) (*grpc.ClientConn, error) {
+	creds, err := tlsconfig.ClientCredentials(tlsCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to set up client TLS: %w", err)
+	}
+	return grpc.NewClient(address,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithConnectParams(grpc.ConnectParams{Backoff: backoff.DefaultConfig}),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	)
+}
+
+// Conn is a gRPC client connection dialed again when its address or TLS settings change. Calls started before are
+// finished on the previous connection, which is closed once they return.
+type Conn struct {
+	dial DialFunc
+
+	mu      sync.RWMutex
+	current *dialedConn
+}
+
+type dialedConn struct {
+	conn    *grpc.ClientConn
+	address string
+	tls     config.TLS
+	calls   sync.WaitGroup
+}
+
+// NewConn dials the address with the TLS settings. Dial is used when dial is nil.
+func NewConn(address string, tlsCfg config.TLS, dial DialFunc) (*Conn, error) {
+	if dial == nil {
+		dial = Dial
+	}
+	conn, err := dial(address, tlsCfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Conn{dial: dial, current: &dialedConn{conn: conn, address: address, tls: tlsCfg}}, nil
+}
+
+// Call runs f with the current connection, keeping it open until f returns.
+func (c *Conn) Call(f func(conn *grpc.ClientConn) error) error {
+	c.mu.RLock()
+	d := c.current
+	// Added under the lock, so the connection is not closed until the call is done once replaced.
+	d.calls.Add(1)
+	c.mu.RUnlock()
+	defer d.calls.Done()
+
+	return f(d.conn)
+}
+
+// Redial replaces the connection when the address or TLS settings differ from the ones it was dialed with. The current
+// connection is kept when dialing fails.
+func (c *Conn) Redial(address string, tlsCfg config.TLS) error {
+	c.mu.RLock()
+	unchanged := c.current.address == address && reflect.DeepEqual(c.current.tls, tlsCfg)
+	c.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	conn, err := c.dial(address, tlsCfg)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	prev := c.current
+	c.current = &dialedConn{conn: conn, address: address, tls: tlsCfg}
+	c.mu.Unlock()
+
+	go func() {
+		prev.calls.Wait()
+		_ = prev.conn.Close()
+	}()
+	return nil
+}
+
+// Verify checks whether the current connection is usable.
+func (c *Conn) Verify(name string) error {
+	return c.Call(func(conn *grpc.ClientConn) error {
+		return VerifyConn(conn, name)
+	})
+}
+
+// Close closes the current connection.
+func (c *Conn) Close() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.current.conn.Close()
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package backend
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+)
+
+func TestConn(t *testing.T) {
+	var dialed []string
+	dial := func(address string, _ config.TLS) (*grpc.ClientConn, error) {
+		dialed = append(dialed, address)
+		return grpc.NewClient(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	c, err := NewConn("localhost:1", config.TLS{}, dial)
+	require.NoError(t, err)
+	defer c.Close()
+
+	require.NoError(t, c.Redial("localhost:1", config.TLS{}))
+	require.Equal(t, []string{"localhost:1"}, dialed, "Connection dialed again with unchanged settings")
+
+	// Call running during redial keeps using the previous connection until it returns.
+	started, finish, state := make(chan *grpc.ClientConn), make(chan struct{}), make(chan connectivity.State, 1)
+	go func() {
+		_ = c.Call(func(conn *grpc.ClientConn) error {
+			started <- conn
+			<-finish
+			state <- conn.GetState()
+			return nil
+		})
+	}()
+	prev := <-started
+
+	require.NoError(t, c.Redial("localhost:2", config.TLS{}))
+	require.Equal(t, []string{"localhost:1", "localhost:2"}, dialed)
+	require.NoError(t, c.Call(func(conn *grpc.ClientConn) error {
+		require.Equal(t, "localhost:2", conn.Target(), "New calls not using the new connection")
+		return nil
+	}))
+
+	close(finish)
+	require.NotEqual(t, connectivity.Shutdown, <-state, "Connection closed during call")
+	require.Eventually(t, func() bool { return prev.GetState() == connectivity.Shutdown }, time.Second, 10*time.Millisecond,
+		"Previous connection not closed once calls returned")
+}
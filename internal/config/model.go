@@ -10,14 +10,170 @@ import (
 )
 
 type Config struct {
+	Server     Server    `yaml:"server"`
 	Endpoints  Endpoints `yaml:"endpoints"`
 	Controller struct {
 		Channel struct {
 			MaxInflightRequests int `yaml:"maxInflightRequests"`
 		} `yaml:"channel"`
-		CreateDeleteWatcherTimeout time.Duration `yaml:"createDeleteWatcherTimeout"`
+		CreateDeleteWatcherTimeout time.Duration  `yaml:"createDeleteWatcherTimeout"`
+		Reconcile                  Reconcile      `yaml:"reconcile"`
+		Orphans                    Orphans        `yaml:"orphans"`
+		LeaderElection             LeaderElection `yaml:"leaderElection"`
+		Intake                     Intake         `yaml:"intake"`
+		Reload                     Reload         `yaml:"reload"`
 	} `yaml:"controller"`
-	Job Job `yaml:"job"`
+	Job     Job     `yaml:"job"`
+	Tracing Tracing `yaml:"tracing"`
+	Logging Logging `yaml:"logging"`
+}
+
+// Server configures listen addresses of the controller.
+type Server struct {
+	// GrpcAddress is served by ProjectService. Defaults to :50051.
+	GrpcAddress string `yaml:"grpcAddress"`
+	// AdminAddress is served by TenantAdmin, kept apart from ProjectService as it is not authenticated. Defaults to
+	// localhost:50052, reachable by port forwarding only.
+	AdminAddress string `yaml:"adminAddress"`
+	// MetricsAddress is served by /metrics, /healthz and /readyz endpoints. Defaults to :9273.
+	MetricsAddress string `yaml:"metricsAddress"`
+	// TLS of the gRPC server. Client certificates are required when CA is set.
+	TLS TLS `yaml:"tls"`
+	// Auth of ProjectService callers.
+	Auth Auth `yaml:"auth"`
+	// ChangeLog is the number of the latest project changes kept for WatchProjects clients resuming the stream.
+	// Defaults to 1000.
+	ChangeLog int `yaml:"changeLog"`
+}
+
+// Auth configures authentication of ProjectService callers with bearer JWTs and the orgs whose projects they receive.
+type Auth struct {
+	Enabled bool `yaml:"enabled"`
+	// JWKSFile holds JSON Web Key Set verifying token signatures. It is read again when modified.
+	JWKSFile string `yaml:"jwksFile"`
+	// JWKSURL serves JSON Web Key Set, e.g. of a Keycloak realm. It is used when JWKSFile is not set.
+	JWKSURL string `yaml:"jwksUrl"`
+	// JWKSRefresh is the period of fetching keys from JWKSURL. Defaults to 5m.
+	JWKSRefresh time.Duration `yaml:"jwksRefresh"`
+	// Issuer and Audience of tokens are verified when set.
+	Issuer   string `yaml:"issuer"`
+	Audience string `yaml:"audience"`
+	// OrgsClaim names the claim listing orgs the caller may receive projects of, "*" grants all. Defaults to orgs.
+	OrgsClaim string `yaml:"orgsClaim"`
+}
+
+// TLS configures transport security of a gRPC server or client. Files are read again when they change, so rotated
+// certificates are used without restart.
+type TLS struct {
+	Enabled bool `yaml:"enabled"`
+	// CertFile and KeyFile hold PEM encoded certificate chain and private key presented to the peer. They are required
+	// by servers and optional for clients, which present them when the server requests a client certificate.
+	CertFile string `yaml:"certFile"`
+	KeyFile  string `yaml:"keyFile"`
+	// CAFile holds PEM encoded certificates verifying the peer. Clients verify servers with system roots when not set.
+	CAFile string `yaml:"caFile"`
+	// ServerName verified in the server certificate overrides the host of the endpoint. Clients only.
+	ServerName string `yaml:"serverName"`
+}
+
+// Log formats.
+const (
+	LogFormatJSON = "json"
+	LogFormatText = "text"
+)
+
+// Logging configures output of the controller logs.
+type Logging struct {
+	// Format can be json or text. Defaults to json.
+	Format string `yaml:"format"`
+	// Level can be debug, info, warn or error. Defaults to info.
+	Level string `yaml:"level"`
+}
+
+// Span exporters.
+const (
+	ExporterOtlp   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+// Tracing configures export of spans of tenant jobs and backend calls.
+type Tracing struct {
+	Enabled bool `yaml:"enabled"`
+	// Exporter can be otlp or stdout. Defaults to otlp.
+	Exporter string `yaml:"exporter"`
+	// Endpoint is host:port of the OTLP gRPC collector.
+	Endpoint string `yaml:"endpoint"`
+	// Insecure disables TLS towards the collector.
+	Insecure bool `yaml:"insecure"`
+	// SampleRatio is the fraction of traces sampled. Zero samples all traces.
+	SampleRatio float64 `yaml:"sampleRatio"`
+}
+
+// Overflow policies of the intake queue.
+const (
+	OverflowDropNewest = "dropNewest"
+	OverflowDropOldest = "dropOldest"
+	OverflowBlock      = "block"
+)
+
+// Intake configures the queue of project events between nexus callbacks and the job manager.
+type Intake struct {
+	// Capacity limits number of tenants with pending events. Zero means unbounded - events of a tenant are merged,
+	// so the queue never holds more entries than there are projects.
+	Capacity int `yaml:"capacity"`
+	// Overflow policy applied at capacity can be dropNewest, dropOldest or block. Defaults to dropOldest.
+	// Dropped events are recovered by reconciliation, so dropping requires it to be enabled.
+	Overflow string `yaml:"overflow"`
+}
+
+// Reload configures applying changes of the config file without restart. The config is reloaded on SIGHUP as well.
+type Reload struct {
+	// Interval between checks of the config file for changes. Zero disables watching of the file.
+	Interval time.Duration `yaml:"interval"`
+}
+
+// Leader election lock types.
+const (
+	LockLease = "lease"
+	LockFile  = "file"
+)
+
+// LeaderElection configures election of a single replica that runs tenant jobs and manages the project watcher.
+type LeaderElection struct {
+	Enabled bool `yaml:"enabled"`
+	// Lock can be lease or file.
+	Lock string `yaml:"lock"`
+	// Name of the lease.
+	Name string `yaml:"name"`
+	// Namespace of the lease defaults to the namespace of the controller pod.
+	Namespace     string        `yaml:"namespace"`
+	Path          string        `yaml:"path"`
+	LeaseDuration time.Duration `yaml:"leaseDuration"`
+	RenewDeadline time.Duration `yaml:"renewDeadline"`
+	RetryPeriod   time.Duration `yaml:"retryPeriod"`
+}
+
+// Reconcile configures periodic comparison of all projects with the state of tenants.
+type Reconcile struct {
+	// Interval between reconciliations. Zero disables reconciliation.
+	Interval time.Duration `yaml:"interval"`
+	// Timeout bounds listing of projects and their watchers. Defaults to the interval, or to two minutes when
+	// reconciliation is disabled.
+	Timeout time.Duration `yaml:"timeout"`
+	// Reinitialize runs initialization of already created tenants again, restoring backends that lost tenant state.
+	Reinitialize bool `yaml:"reinitialize"`
+}
+
+// Orphans configures detection of tenants that hold data in backends while their projects no longer exist.
+type Orphans struct {
+	// Interval between scans. Zero disables detection.
+	Interval time.Duration `yaml:"interval"`
+	// Timeout bounds a single scan. Defaults to the interval.
+	Timeout time.Duration `yaml:"timeout"`
+	// Cleanup schedules cleanup of detected orphans. Orphans are only reported when not set.
+	Cleanup bool `yaml:"cleanup"`
+	// Ignore lists tenants not managed by the controller, e.g. the ones of platform components.
+	Ignore []string `yaml:"ignore"`
 }
 
 type Job struct {
@@ -31,15 +187,86 @@ type Job struct {
 		Max            time.Duration `yaml:"max"`
 		TimeMultiplier float64       `yaml:"timeMultiplier"`
 	} `yaml:"backoff"`
+	// Retry bounds retries of a failing job, after which the job is marked as failed. Zero values mean no limit.
+	Retry struct {
+		MaxAttempts int           `yaml:"maxAttempts"`
+		MaxDuration time.Duration `yaml:"maxDuration"`
+	} `yaml:"retry"`
+	Cleanup struct {
+		// GracePeriod delays purging telemetry of a deleted tenant, so the deletion can still be reverted. Zero means no delay.
+		GracePeriod time.Duration `yaml:"gracePeriod"`
+	} `yaml:"cleanup"`
 	Timeout time.Duration `yaml:"timeout"`
 	Sre     struct {
 		Enabled bool `yaml:"enabled"`
 	} `yaml:"sre"`
+	Store   Store   `yaml:"store"`
+	Archive Archive `yaml:"archive"`
+}
+
+// Job store types.
+const (
+	StoreMemory    = "memory"
+	StoreFile      = "file"
+	StoreConfigMap = "configmap"
+)
+
+type Store struct {
+	// Type can be memory, file or configmap.
+	Type      string `yaml:"type"`
+	Path      string `yaml:"path"`
+	ConfigMap struct {
+		Name string `yaml:"name"`
+		// Namespace defaults to the namespace of the controller pod.
+		Namespace string `yaml:"namespace"`
+	} `yaml:"configMap"`
+}
+
+// Archive configures export of tenant logs and metrics before they are deleted.
+type Archive struct {
+	Enabled bool `yaml:"enabled"`
+	// Lookback is how far into the past tenant data is exported.
+	Lookback time.Duration `yaml:"lookback"`
+	// Window is the time range covered by a single query. Every window is stored as separate objects and checkpointed,
+	// so a retried archive resumes after the last exported window.
+	Window time.Duration `yaml:"window"`
+	// Timeout bounds a single archive attempt. It is separate from the job timeout given to the backend steps.
+	Timeout time.Duration `yaml:"timeout"`
+	Loki    struct {
+		Query string `yaml:"query"`
+		Limit int    `yaml:"limit"`
+	} `yaml:"loki"`
+	Mimir struct {
+		Query string        `yaml:"query"`
+		Step  time.Duration `yaml:"step"`
+	} `yaml:"mimir"`
+	Sink Sink `yaml:"sink"`
+}
+
+// Archive sink types.
+const (
+	SinkLocal = "local"
+	SinkS3    = "s3"
+)
+
+type Sink struct {
+	// Type can be local or s3.
+	Type string `yaml:"type"`
+	Path string `yaml:"path"`
+	// S3 credentials are read from AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables.
+	S3 struct {
+		Endpoint string `yaml:"endpoint"`
+		Region   string `yaml:"region"`
+		Bucket   string `yaml:"bucket"`
+		Prefix   string `yaml:"prefix"`
+	} `yaml:"s3"`
 }
 
 type Mimir struct {
 	Ingester         string             `yaml:"ingester"`
 	Compactor        string             `yaml:"compactor"`
+	Query            string             `yaml:"query"`
+	Tenants          string             `yaml:"tenants"`
 	PollingRate      time.Duration      `yaml:"pollingRate"`
 	DeleteVerifyMode utility.VerifyMode `yaml:"deleteVerifyMode"`
 }
@@ -47,14 +274,31 @@ type Mimir struct {
 type Loki struct {
 	Write            string             `yaml:"write"`
 	Backend          string             `yaml:"backend"`
+	Read             string             `yaml:"read"`
+	Tenants          string             `yaml:"tenants"`
 	PollingRate      time.Duration      `yaml:"pollingRate"`
 	MaxPollingRate   time.Duration      `yaml:"maxPollingRate"`
 	DeleteVerifyMode utility.VerifyMode `yaml:"deleteVerifyMode"`
 }
 
+// Backend names, as used in Endpoints.Backends.
+const (
+	BackendAlertingMonitor = "alertingmonitor"
+	BackendSre             = "sre"
+	BackendLoki            = "loki"
+	BackendMimir           = "mimir"
+)
+
 type Endpoints struct {
 	AlertingMonitor string `yaml:"alertingmonitor"`
 	Sre             string `yaml:"sre"`
 	Mimir           Mimir  `yaml:"mimir"`
 	Loki            Loki   `yaml:"loki"`
+	// Backends lists enabled backends in the order their steps run. All known backends are enabled when empty.
+	Backends []string `yaml:"backends"`
+	// TLS of the gRPC clients of alerting monitor and sre-exporter.
+	TLS struct {
+		AlertingMonitor TLS `yaml:"alertingmonitor"`
+		Sre             TLS `yaml:"sre"`
+	} `yaml:"tls"`
 }
@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// EnvPrefix starts names of environment variables overriding config fields.
+const EnvPrefix = "TENANT_CONTROLLER_"
+
+// Overrides holds values of config fields given outside of the config file, keyed by the path of YAML keys,
+// e.g. job.backoff.initial. Values take precedence over the config file and defaults fill fields left unset.
+// List fields take comma separated values.
+//
+// Precedence from the highest is: command line flags, environment variables, config file, defaults.
+type Overrides map[string]string
+
+// Paths returns paths of all config fields in the order of declaration.
+func Paths() []string {
+	var cfg Config
+	fields := configFields(&cfg)
+	paths := make([]string, 0, len(fields))
+	for _, f := range fields {
+		paths = append(paths, f.path)
+	}
+	return paths
+}
+
+// EnvName returns name of the environment variable overriding the field, e.g. TENANT_CONTROLLER_JOB_BACKOFF_INITIAL.
+func EnvName(path string) string {
+	var b strings.Builder
+	b.WriteString(EnvPrefix)
+	prev := '.'
+	for _, r := range path {
+		switch {
+		case r == '.':
+			b.WriteByte('_')
+		case unicode.IsUpper(r) && prev != '.':
+			b.WriteByte('_')
+			b.WriteRune(r)
+		default:
+			b.WriteRune(unicode.ToUpper(r))
+		}
+		prev = r
+	}
+	return b.String()
+}
+
+// EnvOverrides returns overrides given by environment variables, looked up by the given function, e.g. os.LookupEnv.
+func EnvOverrides(lookup func(string) (string, bool)) Overrides {
+	overrides := Overrides{}
+	for _, path := range Paths() {
+		if value, ok := lookup(EnvName(path)); ok {
+			overrides[path] = value
+		}
+	}
+	return overrides
+}
+
+// RegisterFlags defines a flag named by the path of every config field, e.g. -job.backoff.initial. Returned overrides
+// are filled with the flags set once the flag set is parsed.
+func RegisterFlags(fs *flag.FlagSet) Overrides {
+	overrides := Overrides{}
+	var cfg Config
+	for _, f := range configFields(&cfg) {
+		fs.Var(&overrideFlag{overrides: overrides, path: f.path, isBool: f.value.Kind() == reflect.Bool}, f.path,
+			fmt.Sprintf("overrides %v config field, also set by %v environment variable", f.path, EnvName(f.path)))
+	}
+	return overrides
+}
+
+// apply sets the overridden fields of the config.
+func (o Overrides) apply(cfg *Config) error {
+	var errs []error
+	fields := configFields(cfg)
+	for path, value := range o {
+		i := slices.IndexFunc(fields, func(f field) bool { return f.path == path })
+		if i < 0 {
+			errs = append(errs, FieldError{Field: path, Reason: "unknown field"})
+			continue
+		}
+		if err := setField(fields[i].value, value); err != nil {
+			errs = append(errs, FieldError{Field: path, Reason: fmt.Sprintf("invalid override %q: %v", value, err)})
+		}
+	}
+	return errors.Join(errs...)
+}
+
+type overrideFlag struct {
+	overrides Overrides
+	path      string
+	isBool    bool
+}
+
+// IsBoolFlag lets boolean fields be enabled by the flag without value.
+func (f *overrideFlag) IsBoolFlag() bool {
+	return f.isBool
+}
+
+func (f *overrideFlag) String() string {
+	if f.overrides == nil {
+		return ""
+	}
+	return f.overrides[f.path]
+}
+
+// Set checks the value against the type of the field, so mistakes are reported along with the flag.
+func (f *overrideFlag) Set(value string) error {
+	if err := (Overrides{f.path: value}).apply(&Config{}); err != nil {
+		return err
+	}
+	f.overrides[f.path] = value
+	return nil
+}
+
+type field struct {
+	path  string
+	value reflect.Value
+}
+
+// configFields returns settable leaf fields of the config with paths of their YAML keys.
+func configFields(cfg *Config) []field {
+	var fields []field
+	var walk func(v reflect.Value, prefix string)
+	walk = func(v reflect.Value, prefix string) {
+		for i := range v.NumField() {
+			name, _, _ := strings.Cut(v.Type().Field(i).Tag.Get("yaml"), ",")
+			if name == "" || name == "-" {
+				continue
+			}
+			if prefix != "" {
+				name = prefix + "." + name
+			}
+			if v.Field(i).Kind() == reflect.Struct {
+				walk(v.Field(i), name)
+				continue
+			}
+			fields = append(fields, field{path: name, value: v.Field(i)})
+		}
+	}
+	walk(reflect.ValueOf(cfg).Elem(), "")
+	return fields
+}
+
+func setField(v reflect.Value, value string) error {
+	if v.Type() == reflect.TypeFor[time.Duration]() {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(n))
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		if v.Type().Elem().Kind() != reflect.String {
+			return fmt.Errorf("unsupported type %v", v.Type())
+		}
+		items := reflect.MakeSlice(v.Type(), 0, 0)
+		for item := range strings.SplitSeq(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = reflect.Append(items, reflect.ValueOf(item).Convert(v.Type().Elem()))
+			}
+		}
+		v.Set(items)
+	default:
+		return fmt.Errorf("unsupported type %v", v.Type())
+	}
+	return nil
+}
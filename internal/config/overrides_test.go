@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"flag"
+	"maps"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/util"
+)
+
+func TestEnvName(t *testing.T) {
+	require.Equal(t, "TENANT_CONTROLLER_JOB_BACKOFF_INITIAL", EnvName("job.backoff.initial"))
+	require.Equal(t, "TENANT_CONTROLLER_CONTROLLER_CHANNEL_MAX_INFLIGHT_REQUESTS", EnvName("controller.channel.maxInflightRequests"))
+	require.Equal(t, "TENANT_CONTROLLER_ENDPOINTS_ALERTINGMONITOR", EnvName("endpoints.alertingmonitor"))
+
+	names := map[string]string{}
+	for _, path := range Paths() {
+		name := EnvName(path)
+		require.NotContains(t, names, name, "Environment variable of %v already used by %v", path, names[name])
+		names[name] = path
+	}
+}
+
+func TestOverrides(t *testing.T) {
+	t.Run("Precedence", func(t *testing.T) {
+		env := map[string]string{
+			"TENANT_CONTROLLER_JOB_BACKOFF_INITIAL":    "5s",
+			"TENANT_CONTROLLER_JOB_TIMEOUT":            "1h",
+			"TENANT_CONTROLLER_ENDPOINTS_LOKI_WRITE":   "http://loki:3100",
+			"TENANT_CONTROLLER_SERVER_METRICS_ADDRESS": ":9090",
+		}
+		overrides := EnvOverrides(func(name string) (string, bool) {
+			value, ok := env[name]
+			return value, ok
+		})
+
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		flagOverrides := RegisterFlags(fs)
+		require.NoError(t, fs.Parse([]string{"-job.backoff.initial=7s", "-endpoints.backends=loki, mimir", "-tracing.enabled"}))
+		maps.Copy(overrides, flagOverrides)
+
+		cfg, err := ReadConfig("testdata/test_config.yaml", overrides)
+		require.NoError(t, err)
+		require.Equal(t, 7*time.Second, cfg.Job.Backoff.Initial, "Flag should take precedence over environment")
+		require.Equal(t, time.Hour, cfg.Job.Timeout, "Environment should take precedence over config file")
+		require.Equal(t, "http://loki:3100", cfg.Endpoints.Loki.Write)
+		require.Equal(t, ":9090", cfg.Server.MetricsAddress)
+		require.Equal(t, []string{"loki", "mimir"}, cfg.Endpoints.Backends)
+		require.True(t, cfg.Tracing.Enabled)
+		require.Equal(t, utility.LooseMode, cfg.Endpoints.Loki.DeleteVerifyMode, "Config file value should be kept")
+	})
+
+	t.Run("Defaults fill fields left unset", func(t *testing.T) {
+		cfg, err := Parse([]byte("endpoints:\n  backends: [alertingmonitor]\n"), Overrides{
+			"endpoints.alertingmonitor": "localhost:51001",
+			"job.backoff.max":           "1h",
+		})
+		require.NoError(t, err)
+		require.Equal(t, "localhost:51001", cfg.Endpoints.AlertingMonitor)
+		require.Equal(t, time.Hour, cfg.Job.Backoff.Max)
+		require.Equal(t, 3*time.Second, cfg.Job.Backoff.Initial)
+	})
+
+	t.Run("Invalid values", func(t *testing.T) {
+		_, err := ReadConfig("testdata/test_config.yaml", Overrides{
+			"job.timeout":                   "soon",
+			"controller.orphans.cleanup":    "maybe",
+			"controller.intake.capacity":    "1.5",
+			"tracing.sampleRatio":           "half",
+			"controller.leaderElection.foo": "bar",
+		})
+		require.ErrorContains(t, err, "job.timeout: invalid override")
+		require.ErrorContains(t, err, "controller.orphans.cleanup: invalid override")
+		require.ErrorContains(t, err, "controller.intake.capacity: invalid override")
+		require.ErrorContains(t, err, "tracing.sampleRatio: invalid override")
+		require.ErrorContains(t, err, "controller.leaderElection.foo: unknown field")
+	})
+
+	t.Run("Invalid flag value", func(t *testing.T) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		RegisterFlags(fs)
+		require.Error(t, fs.Parse([]string{"-job.retry.maxAttempts=many"}))
+	})
+}
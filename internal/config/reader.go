@@ -4,22 +4,48 @@
 package config
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 
 	"gopkg.in/yaml.v3"
 )
 
-func ReadConfig(path string) (Config, error) {
+// ReadConfig reads the config file, applies overrides, fills defaults and validates the result. Unknown keys are
+// rejected, so typos are not silently ignored.
+func ReadConfig(path string, overrides Overrides) (Config, error) {
 	file, err := os.ReadFile(path)
 	if err != nil {
-		return Config{}, fmt.Errorf("failed to read file %q: %w", file, err)
+		return Config{}, fmt.Errorf("failed to read file %q: %w", path, err)
 	}
+	return Parse(file, overrides)
+}
 
+// Parse decodes config file contents, applies overrides, fills defaults and validates the result.
+func Parse(data []byte, overrides Overrides) (Config, error) {
 	var cfg Config
-	if err = yaml.Unmarshal(file, &cfg); err != nil {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&cfg); err != nil && !errors.Is(err, io.EOF) {
 		return Config{}, fmt.Errorf("failed to unmarshal: %w", err)
 	}
 
+	if err := overrides.apply(&cfg); err != nil {
+		return Config{}, fmt.Errorf("invalid overrides:\n%w", err)
+	}
+	cfg.SetDefaults()
+	if err := cfg.Validate(); err != nil {
+		return Config{}, fmt.Errorf("invalid config:\n%w", err)
+	}
 	return cfg, nil
 }
+
+// Hash returns SHA-256 of config file contents, so the active config can be compared with the deployed file.
+func Hash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
@@ -4,6 +4,8 @@
 package config
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -14,34 +16,93 @@ import (
 
 func TestReadConfig(t *testing.T) {
 	t.Run("Valid config file", func(t *testing.T) {
-		configFile, err := ReadConfig("testdata/test_config.yaml")
+		configFile, err := ReadConfig("testdata/test_config.yaml", nil)
 		require.NoError(t, err)
+		require.Equal(t, ":50051", configFile.Server.GrpcAddress, "Config value different from expected")
+		require.Equal(t, ":9273", configFile.Server.MetricsAddress, "Config value different from expected")
+		require.Equal(t, "localhost:50052", configFile.Server.AdminAddress, "Config value different from expected")
+		require.Equal(t, 500, configFile.Server.ChangeLog, "Config value different from expected")
 		require.Equal(t, 20, configFile.Controller.Channel.MaxInflightRequests, "Config value different from expected")
 		require.Equal(t, 30*time.Minute, configFile.Job.Timeout, "Config value different from expected")
 		require.Equal(t, time.Minute, configFile.Job.Manager.Deletion.Rate, "Config value different from expected")
 		require.Equal(t, 10*time.Second, configFile.Job.Backoff.Initial, "Config value different from expected")
 		require.Equal(t, 10*time.Minute, configFile.Job.Backoff.Max, "Config value different from expected")
 		require.InEpsilon(t, 1.6, configFile.Job.Backoff.TimeMultiplier, 0, "Config value different from expected")
+		require.Equal(t, 50, configFile.Job.Retry.MaxAttempts, "Config value different from expected")
+		require.Equal(t, 24*time.Hour, configFile.Job.Retry.MaxDuration, "Config value different from expected")
+		require.Equal(t, 72*time.Hour, configFile.Job.Cleanup.GracePeriod, "Config value different from expected")
 		require.Equal(t, "http://localhost:3100", configFile.Endpoints.Loki.Write, "Config value different from expected")
 		require.Equal(t, "http://localhost:3100", configFile.Endpoints.Loki.Backend, "Config value different from expected")
+		require.Equal(t, "http://localhost:3100/tenants", configFile.Endpoints.Loki.Tenants, "Config value different from expected")
 		require.Equal(t, 20*time.Second, configFile.Endpoints.Loki.PollingRate, "Config value different from expected")
 		require.Equal(t, time.Minute, configFile.Endpoints.Loki.MaxPollingRate, "Config value different from expected")
 		require.Equal(t, utility.LooseMode, configFile.Endpoints.Loki.DeleteVerifyMode, "Config value different from expected")
 		require.Equal(t, "http://localhost:8080", configFile.Endpoints.Mimir.Compactor, "Config value different from expected")
 		require.Equal(t, "http://localhost:8080", configFile.Endpoints.Mimir.Ingester, "Config value different from expected")
+		require.Equal(t, "http://localhost:8080/store-gateway/tenants", configFile.Endpoints.Mimir.Tenants, "Config value different from expected")
 		require.Equal(t, 20*time.Second, configFile.Endpoints.Mimir.PollingRate, "Config value different from expected")
 		require.Equal(t, utility.LooseMode, configFile.Endpoints.Mimir.DeleteVerifyMode, "Config value different from expected")
 		require.Equal(t, "http://localhost:8080", configFile.Endpoints.AlertingMonitor, "Config value different from expected")
 		require.Equal(t, 10*time.Minute, configFile.Controller.CreateDeleteWatcherTimeout, "Config value different from expected")
+		require.Equal(t, 10*time.Minute, configFile.Controller.Reconcile.Interval, "Config value different from expected")
+		require.Equal(t, time.Minute, configFile.Controller.Reconcile.Timeout, "Config value different from expected")
+		require.True(t, configFile.Controller.Reconcile.Reinitialize, "Config value different from expected")
+		require.Equal(t, time.Hour, configFile.Controller.Orphans.Interval, "Config value different from expected")
+		require.Equal(t, 5*time.Minute, configFile.Controller.Orphans.Timeout, "Config value different from expected")
+		require.True(t, configFile.Controller.Orphans.Cleanup, "Config value different from expected")
+		require.Equal(t, []string{"edgenode-system"}, configFile.Controller.Orphans.Ignore, "Config value different from expected")
+		require.True(t, configFile.Controller.LeaderElection.Enabled, "Config value different from expected")
+		require.Equal(t, "lease", configFile.Controller.LeaderElection.Lock, "Config value different from expected")
+		require.Equal(t, "observability-tenant-controller", configFile.Controller.LeaderElection.Name, "Config value different from expected")
+		require.Equal(t, 15*time.Second, configFile.Controller.LeaderElection.LeaseDuration, "Config value different from expected")
+		require.Equal(t, 10*time.Second, configFile.Controller.LeaderElection.RenewDeadline, "Config value different from expected")
+		require.Equal(t, 2*time.Second, configFile.Controller.LeaderElection.RetryPeriod, "Config value different from expected")
+		require.Equal(t, 5000, configFile.Controller.Intake.Capacity, "Config value different from expected")
+		require.Equal(t, "dropOldest", configFile.Controller.Intake.Overflow, "Config value different from expected")
+		require.Equal(t, 30*time.Second, configFile.Controller.Reload.Interval, "Config value different from expected")
 		require.Equal(t, "http://localhost:8080", configFile.Endpoints.Sre, "Config value different from expected")
+		require.Equal(t, []string{"alertingmonitor", "sre", "loki", "mimir"}, configFile.Endpoints.Backends, "Config value different from expected")
 		require.True(t, configFile.Job.Sre.Enabled, "Config value different from expected")
+		require.Equal(t, "configmap", configFile.Job.Store.Type, "Config value different from expected")
+		require.Equal(t, "observability-tenant-controller-jobs", configFile.Job.Store.ConfigMap.Name, "Config value different from expected")
+		require.Equal(t, "orch-platform", configFile.Job.Store.ConfigMap.Namespace, "Config value different from expected")
+		require.False(t, configFile.Job.Archive.Enabled, "Config value different from expected")
+		require.Equal(t, 720*time.Hour, configFile.Job.Archive.Lookback, "Config value different from expected")
+		require.Equal(t, time.Hour, configFile.Job.Archive.Window, "Config value different from expected")
+		require.Equal(t, `{service_name=~".+"}`, configFile.Job.Archive.Loki.Query, "Config value different from expected")
+		require.Equal(t, 5000, configFile.Job.Archive.Loki.Limit, "Config value different from expected")
+		require.Equal(t, `{__name__=~".+"}`, configFile.Job.Archive.Mimir.Query, "Config value different from expected")
+		require.Equal(t, time.Minute, configFile.Job.Archive.Mimir.Step, "Config value different from expected")
+		require.Equal(t, "s3", configFile.Job.Archive.Sink.Type, "Config value different from expected")
+		require.Equal(t, "http://localhost:9000", configFile.Job.Archive.Sink.S3.Endpoint, "Config value different from expected")
+		require.Equal(t, "us-east-1", configFile.Job.Archive.Sink.S3.Region, "Config value different from expected")
+		require.Equal(t, "archive", configFile.Job.Archive.Sink.S3.Bucket, "Config value different from expected")
+		require.Equal(t, "tenants", configFile.Job.Archive.Sink.S3.Prefix, "Config value different from expected")
+		require.True(t, configFile.Tracing.Enabled, "Config value different from expected")
+		require.Equal(t, "otlp", configFile.Tracing.Exporter, "Config value different from expected")
+		require.Equal(t, "localhost:4317", configFile.Tracing.Endpoint, "Config value different from expected")
+		require.True(t, configFile.Tracing.Insecure, "Config value different from expected")
+		require.InDelta(t, 0.5, configFile.Tracing.SampleRatio, 0, "Config value different from expected")
+		require.Equal(t, "text", configFile.Logging.Format, "Config value different from expected")
+		require.Equal(t, "debug", configFile.Logging.Level, "Config value different from expected")
 	})
 	t.Run("Invalid config file name", func(t *testing.T) {
-		_, err := ReadConfig("testdata/invalid_file_name.yaml")
+		_, err := ReadConfig("testdata/invalid_file_name.yaml", nil)
 		require.Error(t, err)
 	})
 	t.Run("Invalid config file", func(t *testing.T) {
-		_, err := ReadConfig("testdata/test_config_malformed.yaml")
+		_, err := ReadConfig("testdata/test_config_malformed.yaml", nil)
 		require.Error(t, err)
 	})
+	t.Run("Unknown key", func(t *testing.T) {
+		_, err := ReadConfig("testdata/test_config_unknown_key.yaml", nil)
+		require.ErrorContains(t, err, "field backof not found")
+	})
+	t.Run("Empty config file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "config.yaml")
+		require.NoError(t, os.WriteFile(path, nil, 0o600))
+		_, err := ReadConfig(path, nil)
+		require.ErrorContains(t, err, "endpoints.alertingmonitor: must be set")
+		require.ErrorContains(t, err, "endpoints.loki.write: must be set")
+	})
 }
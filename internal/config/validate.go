@@ -0,0 +1,288 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/util"
+)
+
+// Values accepted by the components configured here.
+var (
+	knownBackends = []string{BackendAlertingMonitor, BackendSre, BackendLoki, BackendMimir}
+	verifyModes   = []utility.VerifyMode{utility.StrictMode, utility.LooseMode}
+	overflows     = []string{OverflowDropNewest, OverflowDropOldest, OverflowBlock}
+	lockTypes     = []string{LockLease, LockFile}
+	storeTypes    = []string{StoreMemory, StoreFile, StoreConfigMap}
+	sinkTypes     = []string{SinkLocal, SinkS3}
+	exporters     = []string{ExporterOtlp, ExporterStdout}
+	logFormats    = []string{LogFormatJSON, LogFormatText}
+)
+
+// maxArchiveWindows limits the number of windows the archive is split into.
+const maxArchiveWindows = 10000
+
+// FieldError describes invalid value of a config field. Field is the path of YAML keys.
+type FieldError struct {
+	Field  string
+	Reason string
+}
+
+func (e FieldError) Error() string {
+	return fmt.Sprintf("%v: %v", e.Field, e.Reason)
+}
+
+// SetDefaults fills fields that are not set and have no meaningful zero value.
+func (c *Config) SetDefaults() {
+	setDefault(&c.Server.GrpcAddress, ":50051")
+	setDefault(&c.Server.MetricsAddress, ":9273")
+	setDefault(&c.Server.AdminAddress, "localhost:50052")
+	setDefault(&c.Server.Auth.JWKSRefresh, 5*time.Minute)
+	setDefault(&c.Server.Auth.OrgsClaim, "orgs")
+	setDefault(&c.Server.ChangeLog, 1000)
+
+	setDefault(&c.Controller.Channel.MaxInflightRequests, 1000)
+	setDefault(&c.Controller.CreateDeleteWatcherTimeout, 10*time.Minute)
+	setDefault(&c.Controller.Intake.Overflow, OverflowDropOldest)
+
+	setDefault(&c.Endpoints.Mimir.PollingRate, 20*time.Second)
+	setDefault(&c.Endpoints.Mimir.DeleteVerifyMode, utility.LooseMode)
+	setDefault(&c.Endpoints.Loki.PollingRate, 20*time.Second)
+	setDefault(&c.Endpoints.Loki.MaxPollingRate, time.Minute)
+	setDefault(&c.Endpoints.Loki.DeleteVerifyMode, utility.LooseMode)
+
+	setDefault(&c.Job.Manager.Deletion.Rate, time.Minute)
+	setDefault(&c.Job.Backoff.Initial, 3*time.Second)
+	setDefault(&c.Job.Backoff.Max, 10*time.Minute)
+	setDefault(&c.Job.Backoff.TimeMultiplier, 1.6)
+	setDefault(&c.Job.Timeout, 30*time.Minute)
+	setDefault(&c.Job.Store.Type, StoreMemory)
+	setDefault(&c.Job.Archive.Window, time.Hour)
+	setDefault(&c.Job.Archive.Timeout, 2*time.Hour)
+	setDefault(&c.Job.Archive.Sink.Type, SinkLocal)
+
+	setDefault(&c.Tracing.Exporter, ExporterOtlp)
+	setDefault(&c.Logging.Format, LogFormatJSON)
+	setDefault(&c.Logging.Level, "info")
+}
+
+func setDefault[T comparable](field *T, value T) {
+	var zero T
+	if *field == zero {
+		*field = value
+	}
+}
+
+// Validate checks values of all fields and returns every problem found, joined into a single error.
+func (c *Config) Validate() error {
+	v := &validator{}
+
+	v.address(c.Server.GrpcAddress, "server.grpcAddress")
+	v.address(c.Server.MetricsAddress, "server.metricsAddress")
+	v.address(c.Server.AdminAddress, "server.adminAddress")
+	v.check(c.Server.AdminAddress != c.Server.GrpcAddress, "server.adminAddress", "must differ from server.grpcAddress")
+	v.check(c.Server.ChangeLog > 0, "server.changeLog", "must be positive")
+	if c.Server.TLS.Enabled {
+		v.required(c.Server.TLS.CertFile, "server.tls.certFile")
+		v.required(c.Server.TLS.KeyFile, "server.tls.keyFile")
+		v.check(c.Server.TLS.ServerName == "", "server.tls.serverName", "is only used by clients")
+	}
+	if a := c.Server.Auth; a.Enabled {
+		v.check(a.JWKSFile != "" || a.JWKSURL != "", "server.auth.jwksFile", "jwksFile or jwksUrl must be set")
+		v.url(a.JWKSURL, "server.auth.jwksUrl", false)
+		v.positive(a.JWKSRefresh, "server.auth.jwksRefresh")
+	}
+	c.validateEndpoints(v)
+
+	v.check(c.Controller.Channel.MaxInflightRequests >= 0, "controller.channel.maxInflightRequests", "must not be negative")
+	v.positive(c.Controller.CreateDeleteWatcherTimeout, "controller.createDeleteWatcherTimeout")
+	v.nonNegative(c.Controller.Reconcile.Interval, "controller.reconcile.interval")
+	v.nonNegative(c.Controller.Reconcile.Timeout, "controller.reconcile.timeout")
+	v.nonNegative(c.Controller.Orphans.Interval, "controller.orphans.interval")
+	v.nonNegative(c.Controller.Orphans.Timeout, "controller.orphans.timeout")
+	v.check(c.Controller.Intake.Capacity >= 0, "controller.intake.capacity", "must not be negative")
+	oneOf(v, c.Controller.Intake.Overflow, overflows, "controller.intake.overflow")
+	// Events dropped at capacity are recovered by reconciliation only.
+	v.check(c.Controller.Intake.Capacity == 0 || c.Controller.Intake.Overflow == OverflowBlock || c.Controller.Reconcile.Interval > 0,
+		"controller.intake.overflow", "must be block when capacity is set and reconciliation is disabled")
+	v.nonNegative(c.Controller.Reload.Interval, "controller.reload.interval")
+
+	if le := c.Controller.LeaderElection; le.Enabled {
+		oneOf(v, le.Lock, append([]string{""}, lockTypes...), "controller.leaderElection.lock")
+		v.check(le.Lock != LockFile || le.Path != "", "controller.leaderElection.path", "must be set for file lock")
+		v.nonNegative(le.LeaseDuration, "controller.leaderElection.leaseDuration")
+		v.nonNegative(le.RenewDeadline, "controller.leaderElection.renewDeadline")
+		v.nonNegative(le.RetryPeriod, "controller.leaderElection.retryPeriod")
+		if le.LeaseDuration > 0 && le.RenewDeadline > 0 {
+			v.check(le.RenewDeadline < le.LeaseDuration, "controller.leaderElection.renewDeadline", "must be shorter than leaseDuration")
+		}
+		if le.RenewDeadline > 0 && le.RetryPeriod > 0 {
+			v.check(le.RetryPeriod < le.RenewDeadline, "controller.leaderElection.retryPeriod", "must be shorter than renewDeadline")
+		}
+	}
+
+	c.validateJob(v)
+
+	if c.Tracing.Enabled {
+		oneOf(v, c.Tracing.Exporter, exporters, "tracing.exporter")
+		v.check(c.Tracing.Exporter != ExporterOtlp || c.Tracing.Endpoint != "", "tracing.endpoint", "must be set for otlp exporter")
+	}
+	v.check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "tracing.sampleRatio", "must be between 0 and 1")
+
+	oneOf(v, c.Logging.Format, logFormats, "logging.format")
+	var level slog.Level
+	v.check(level.UnmarshalText([]byte(c.Logging.Level)) == nil, "logging.level", fmt.Sprintf("unknown level %q", c.Logging.Level))
+
+	return v.err()
+}
+
+func (c *Config) validateEndpoints(v *validator) {
+	e := c.Endpoints
+	seen := map[string]bool{}
+	for _, name := range e.Backends {
+		v.check(slices.Contains(knownBackends, name), "endpoints.backends", fmt.Sprintf("unknown backend %q", name))
+		v.check(!seen[name], "endpoints.backends", fmt.Sprintf("backend %q listed more than once", name))
+		seen[name] = true
+	}
+	// Listing backends takes precedence over job.sre.enabled, so disabling sre-exporter there while listing it contradicts.
+	v.check(len(e.Backends) == 0 || !seen[BackendSre] || c.Job.Sre.Enabled, "job.sre.enabled",
+		"must be set when sre is listed in endpoints.backends")
+	enabled := func(name string) bool {
+		if len(e.Backends) == 0 {
+			return name != BackendSre || c.Job.Sre.Enabled
+		}
+		return seen[name]
+	}
+
+	if enabled(BackendAlertingMonitor) {
+		v.required(e.AlertingMonitor, "endpoints.alertingmonitor")
+		v.clientTLS(e.TLS.AlertingMonitor, "endpoints.tls.alertingmonitor")
+	}
+	if enabled(BackendSre) {
+		v.required(e.Sre, "endpoints.sre")
+		v.clientTLS(e.TLS.Sre, "endpoints.tls.sre")
+	}
+	if enabled(BackendMimir) {
+		v.url(e.Mimir.Ingester, "endpoints.mimir.ingester", true)
+		v.url(e.Mimir.Compactor, "endpoints.mimir.compactor", true)
+		v.url(e.Mimir.Tenants, "endpoints.mimir.tenants", false)
+		v.positive(e.Mimir.PollingRate, "endpoints.mimir.pollingRate")
+		oneOf(v, e.Mimir.DeleteVerifyMode, verifyModes, "endpoints.mimir.deleteVerifyMode")
+	}
+	if enabled(BackendLoki) {
+		v.url(e.Loki.Write, "endpoints.loki.write", true)
+		v.url(e.Loki.Backend, "endpoints.loki.backend", true)
+		v.url(e.Loki.Tenants, "endpoints.loki.tenants", false)
+		v.positive(e.Loki.PollingRate, "endpoints.loki.pollingRate")
+		v.check(e.Loki.MaxPollingRate >= e.Loki.PollingRate, "endpoints.loki.maxPollingRate", "must not be shorter than pollingRate")
+		oneOf(v, e.Loki.DeleteVerifyMode, verifyModes, "endpoints.loki.deleteVerifyMode")
+	}
+
+	// Only archive queries tenant data, the backends are reconfigured through their write and admin endpoints.
+	if c.Job.Archive.Enabled {
+		v.url(e.Mimir.Query, "endpoints.mimir.query", true)
+		v.url(e.Loki.Read, "endpoints.loki.read", true)
+	}
+}
+
+func (c *Config) validateJob(v *validator) {
+	j := c.Job
+	// Deletion rate drives a ticker and zero backoff values would retry in a busy loop.
+	v.positive(j.Manager.Deletion.Rate, "job.manager.deletion.rate")
+	v.positive(j.Backoff.Initial, "job.backoff.initial")
+	v.check(j.Backoff.Max >= j.Backoff.Initial, "job.backoff.max", "must not be shorter than initial")
+	v.check(j.Backoff.TimeMultiplier >= 1, "job.backoff.timeMultiplier", "must be at least 1")
+	v.check(j.Retry.MaxAttempts >= 0, "job.retry.maxAttempts", "must not be negative")
+	v.nonNegative(j.Retry.MaxDuration, "job.retry.maxDuration")
+	v.nonNegative(j.Cleanup.GracePeriod, "job.cleanup.gracePeriod")
+	v.positive(j.Timeout, "job.timeout")
+
+	oneOf(v, j.Store.Type, storeTypes, "job.store.type")
+	v.check(j.Store.Type != StoreFile || j.Store.Path != "", "job.store.path", "must be set for file store")
+	v.check(j.Store.Type != StoreConfigMap || j.Store.ConfigMap.Name != "", "job.store.configMap.name", "must be set for configmap store")
+
+	if !j.Archive.Enabled {
+		return
+	}
+	// Zero lookback would export nothing, archiver refuses it.
+	v.positive(j.Archive.Lookback, "job.archive.lookback")
+	v.positive(j.Archive.Window, "job.archive.window")
+	// Progress of every window is persisted in the job store.
+	if j.Archive.Window > 0 {
+		windows := (j.Archive.Lookback + j.Archive.Window - 1) / j.Archive.Window
+		v.check(windows <= maxArchiveWindows, "job.archive.window",
+			fmt.Sprintf("must split lookback into at most %d windows", maxArchiveWindows))
+	}
+	v.positive(j.Archive.Timeout, "job.archive.timeout")
+	v.check(j.Archive.Loki.Limit >= 0, "job.archive.loki.limit", "must not be negative")
+	v.nonNegative(j.Archive.Mimir.Step, "job.archive.mimir.step")
+	oneOf(v, j.Archive.Sink.Type, sinkTypes, "job.archive.sink.type")
+	switch j.Archive.Sink.Type {
+	case SinkLocal:
+		v.required(j.Archive.Sink.Path, "job.archive.sink.path")
+	case SinkS3:
+		v.url(j.Archive.Sink.S3.Endpoint, "job.archive.sink.s3.endpoint", true)
+		v.required(j.Archive.Sink.S3.Bucket, "job.archive.sink.s3.bucket")
+	}
+}
+
+// validator collects errors of all invalid fields.
+type validator struct {
+	errs []error
+}
+
+func (v *validator) check(ok bool, field, reason string) {
+	if !ok {
+		v.errs = append(v.errs, FieldError{Field: field, Reason: reason})
+	}
+}
+
+func (v *validator) required(value, field string) {
+	v.check(value != "", field, "must be set")
+}
+
+func (v *validator) positive(d time.Duration, field string) {
+	v.check(d > 0, field, "must be positive")
+}
+
+func (v *validator) nonNegative(d time.Duration, field string) {
+	v.check(d >= 0, field, "must not be negative")
+}
+
+func oneOf[T ~string](v *validator, value T, allowed []T, field string) {
+	v.check(slices.Contains(allowed, value), field, fmt.Sprintf("%q is not one of %q", value, allowed))
+}
+
+func (v *validator) url(value, field string, required bool) {
+	if value == "" {
+		v.check(!required, field, "must be set")
+		return
+	}
+	u, err := url.Parse(value)
+	v.check(err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "", field,
+		fmt.Sprintf("%q is not a valid http(s) URL", value))
+}
+
+// clientTLS checks that client certificate and key are given together.
+func (v *validator) clientTLS(t TLS, field string) {
+	if t.Enabled {
+		v.check((t.CertFile == "") == (t.KeyFile == ""), field, "certFile and keyFile must be set together")
+	}
+}
+
+func (v *validator) address(value, field string) {
+	_, _, err := net.SplitHostPort(value)
+	v.check(err == nil, field, fmt.Sprintf("%q is not a valid host:port address", value))
+}
+
+func (v *validator) err() error {
+	return errors.Join(v.errs...)
+}
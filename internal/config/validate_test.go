@@ -0,0 +1,238 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/util"
+)
+
+func validConfig() Config {
+	var cfg Config
+	cfg.Endpoints = Endpoints{
+		AlertingMonitor: "localhost:51001",
+		Mimir: Mimir{
+			Ingester:  "http://localhost:8080",
+			Compactor: "http://localhost:8080",
+		},
+		Loki: Loki{
+			Write:   "http://localhost:3100",
+			Backend: "http://localhost:3100",
+		},
+	}
+	return cfg
+}
+
+func TestSetDefaults(t *testing.T) {
+	cfg := validConfig()
+	cfg.Job.Backoff.Initial = time.Second
+	cfg.SetDefaults()
+
+	require.Equal(t, ":50051", cfg.Server.GrpcAddress)
+	require.Equal(t, ":9273", cfg.Server.MetricsAddress)
+	require.Equal(t, 5*time.Minute, cfg.Server.Auth.JWKSRefresh)
+	require.Equal(t, "orgs", cfg.Server.Auth.OrgsClaim)
+	require.Equal(t, 1000, cfg.Controller.Channel.MaxInflightRequests)
+	require.Equal(t, 10*time.Minute, cfg.Controller.CreateDeleteWatcherTimeout)
+	require.Equal(t, "dropOldest", cfg.Controller.Intake.Overflow)
+	require.Equal(t, 20*time.Second, cfg.Endpoints.Mimir.PollingRate)
+	require.Equal(t, utility.LooseMode, cfg.Endpoints.Mimir.DeleteVerifyMode)
+	require.Equal(t, time.Minute, cfg.Endpoints.Loki.MaxPollingRate)
+	require.Equal(t, time.Minute, cfg.Job.Manager.Deletion.Rate)
+	require.Equal(t, time.Second, cfg.Job.Backoff.Initial, "Set value should be kept")
+	require.Equal(t, 10*time.Minute, cfg.Job.Backoff.Max)
+	require.InDelta(t, 1.6, cfg.Job.Backoff.TimeMultiplier, 0)
+	require.Equal(t, 30*time.Minute, cfg.Job.Timeout)
+	require.Equal(t, "memory", cfg.Job.Store.Type)
+	require.Equal(t, time.Hour, cfg.Job.Archive.Window)
+	require.Equal(t, 2*time.Hour, cfg.Job.Archive.Timeout)
+	require.Equal(t, "json", cfg.Logging.Format)
+	require.Equal(t, "info", cfg.Logging.Level)
+	require.NoError(t, cfg.Validate())
+}
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		modify   func(cfg *Config)
+		expected []string
+	}{
+		"Defaults are valid": {modify: func(*Config) {}},
+		"Missing endpoints": {
+			modify: func(cfg *Config) {
+				cfg.Endpoints = Endpoints{}
+			},
+			expected: []string{
+				"endpoints.alertingmonitor", "endpoints.mimir.ingester", "endpoints.mimir.compactor",
+				"endpoints.loki.write", "endpoints.loki.backend",
+			},
+		},
+		"Endpoints of disabled backends are not required": {
+			modify: func(cfg *Config) {
+				cfg.Endpoints = Endpoints{AlertingMonitor: "localhost:51001", Backends: []string{"alertingmonitor"}}
+			},
+		},
+		"Sre endpoint required when sre is enabled": {
+			modify: func(cfg *Config) {
+				cfg.Job.Sre.Enabled = true
+			},
+			expected: []string{"endpoints.sre"},
+		},
+		"Sre listed in backends while disabled": {
+			modify: func(cfg *Config) {
+				cfg.Endpoints.Sre = "localhost:50051"
+				cfg.Endpoints.Backends = []string{"alertingmonitor", "sre", "loki", "mimir"}
+			},
+			expected: []string{"job.sre.enabled"},
+		},
+		"Unknown and duplicated backends": {
+			modify: func(cfg *Config) {
+				cfg.Endpoints.Backends = []string{"loki", "loki", "tempo"}
+			},
+			expected: []string{"endpoints.backends", "endpoints.backends"},
+		},
+		"Invalid URLs and verify mode": {
+			modify: func(cfg *Config) {
+				cfg.Endpoints.Mimir.Tenants = "localhost:8080"
+				cfg.Endpoints.Loki.Tenants = "://"
+				cfg.Endpoints.Loki.DeleteVerifyMode = "eventual"
+			},
+			expected: []string{"endpoints.mimir.tenants", "endpoints.loki.tenants", "endpoints.loki.deleteVerifyMode"},
+		},
+		"Zero rates and backoff": {
+			modify: func(cfg *Config) {
+				cfg.Job.Manager.Deletion.Rate = -time.Second
+				cfg.Job.Backoff.TimeMultiplier = 0.5
+				cfg.Job.Backoff.Max = time.Second
+			},
+			expected: []string{"job.manager.deletion.rate", "job.backoff.max", "job.backoff.timeMultiplier"},
+		},
+		"Leader election timings": {
+			modify: func(cfg *Config) {
+				cfg.Controller.LeaderElection = LeaderElection{Enabled: true, Lock: "file", LeaseDuration: time.Second,
+					RenewDeadline: 2 * time.Second, RetryPeriod: 3 * time.Second}
+			},
+			expected: []string{"controller.leaderElection.path", "controller.leaderElection.renewDeadline", "controller.leaderElection.retryPeriod"},
+		},
+		"Negative reconcile timeout": {
+			modify: func(cfg *Config) {
+				cfg.Controller.Reconcile.Timeout = -time.Minute
+			},
+			expected: []string{"controller.reconcile.timeout"},
+		},
+		"Dropping intake without reconciliation": {
+			modify: func(cfg *Config) {
+				cfg.Controller.Intake.Capacity = 100
+				cfg.Controller.Intake.Overflow = "dropOldest"
+			},
+			expected: []string{"controller.intake.overflow"},
+		},
+		"Dropping intake with reconciliation": {
+			modify: func(cfg *Config) {
+				cfg.Controller.Intake.Capacity = 100
+				cfg.Controller.Reconcile.Interval = time.Minute
+			},
+		},
+		"Archive sink": {
+			modify: func(cfg *Config) {
+				cfg.Job.Archive.Enabled = true
+				cfg.Endpoints.Mimir.Query = "http://localhost:8080"
+				cfg.Endpoints.Loki.Read = "http://localhost:3100"
+				cfg.Job.Archive.Lookback = time.Hour
+				cfg.Job.Archive.Sink.Type = "s3"
+			},
+			expected: []string{"job.archive.sink.s3.endpoint", "job.archive.sink.s3.bucket"},
+		},
+		"Archive endpoints": {
+			modify: func(cfg *Config) {
+				cfg.Job.Archive.Enabled = true
+				cfg.Job.Archive.Lookback = time.Hour
+				cfg.Job.Archive.Sink.Path = "/archive"
+			},
+			expected: []string{"endpoints.mimir.query", "endpoints.loki.read"},
+		},
+		"Store location": {
+			modify: func(cfg *Config) {
+				cfg.Job.Store.Type = "configmap"
+			},
+			expected: []string{"job.store.configMap.name"},
+		},
+		"Archive lookback": {
+			modify: func(cfg *Config) {
+				cfg.Job.Archive.Enabled = true
+				cfg.Endpoints.Mimir.Query = "http://localhost:8080"
+				cfg.Endpoints.Loki.Read = "http://localhost:3100"
+				cfg.Job.Archive.Sink.Type = "local"
+				cfg.Job.Archive.Sink.Path = "/archive"
+			},
+			expected: []string{"job.archive.lookback"},
+		},
+		"Archive windows and timeout": {
+			modify: func(cfg *Config) {
+				cfg.Job.Archive.Enabled = true
+				cfg.Endpoints.Mimir.Query = "http://localhost:8080"
+				cfg.Endpoints.Loki.Read = "http://localhost:3100"
+				cfg.Job.Archive.Lookback = 720 * time.Hour
+				cfg.Job.Archive.Window = time.Second
+				cfg.Job.Archive.Timeout = -time.Hour
+				cfg.Job.Archive.Sink.Type = "local"
+				cfg.Job.Archive.Sink.Path = "/archive"
+			},
+			expected: []string{"job.archive.window", "job.archive.timeout"},
+		},
+		"Server": {
+			modify: func(cfg *Config) {
+				cfg.Server = Server{GrpcAddress: ":50051", MetricsAddress: "9273", AdminAddress: ":50051", ChangeLog: -1}
+			},
+			expected: []string{"server.metricsAddress", "server.adminAddress", "server.changeLog"},
+		},
+		"TLS": {
+			modify: func(cfg *Config) {
+				cfg.Server.TLS = TLS{Enabled: true, KeyFile: "tls.key", ServerName: "controller"}
+				cfg.Endpoints.TLS.AlertingMonitor = TLS{Enabled: true, CertFile: "tls.crt"}
+				cfg.Endpoints.TLS.Sre = TLS{Enabled: true, CertFile: "tls.crt"}
+			},
+			expected: []string{"server.tls.certFile", "server.tls.serverName", "endpoints.tls.alertingmonitor"},
+		},
+		"Auth": {
+			modify: func(cfg *Config) {
+				cfg.Server.Auth = Auth{Enabled: true}
+			},
+			expected: []string{"server.auth.jwksFile"},
+		},
+		"Tracing and logging": {
+			modify: func(cfg *Config) {
+				cfg.Tracing = Tracing{Enabled: true, Exporter: "otlp", SampleRatio: 2}
+				cfg.Logging = Logging{Format: "xml", Level: "verbose"}
+			},
+			expected: []string{"tracing.endpoint", "tracing.sampleRatio", "logging.format", "logging.level"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := validConfig()
+			tt.modify(&cfg)
+			cfg.SetDefaults()
+
+			err := cfg.Validate()
+			if len(tt.expected) == 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			var fields []string
+			for _, e := range err.(interface{ Unwrap() []error }).Unwrap() {
+				var fieldErr FieldError
+				require.True(t, errors.As(e, &fieldErr))
+				fields = append(fields, fieldErr.Field)
+			}
+			require.ElementsMatch(t, tt.expected, fields, "Invalid fields different from expected")
+		})
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
+	"reflect"
+	"sync/atomic"
 	"time"
 
 	projectwatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectwatcher.edge-orchestrator.intel.com/v1"
@@ -16,7 +18,11 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
 
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/health"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
 	"github.com/open-edge-platform/o11y-tenant-controller/internal/projects"
 	"github.com/open-edge-platform/o11y-tenant-controller/internal/util"
 )
@@ -33,16 +39,39 @@ type TenantController struct {
 	client         *nexus.Clientset
 	server         *http.Server
 	watcherTimeout time.Duration
+	// leading is set on the replica that runs tenant jobs, only its project events are sent to ComSig.
+	leading atomic.Bool
+	intake  *intake
+	// registrations of project callbacks report whether informers delivered the initial list of objects.
+	registrations []cache.ResourceEventHandlerRegistration
 
-	grpcServer projects.Server
+	grpcServer *projects.Server
 }
 
 type CommChannel struct {
 	Project *nexus.RuntimeprojectRuntimeProject
 	Status  Action
+	// Force restarts the job even if it already runs the action, e.g. to initialize a created tenant again.
+	Force bool
 }
 
-func New(buffer int, watcherTimeout time.Duration, grpcServer *projects.Server) (*TenantController, error) {
+// Coalesce returns the event replacing the pending event e of the same tenant. The latest desired state wins, while
+// forced restart of the pending event is kept unless the action changes anyway.
+func (e CommChannel) Coalesce(next CommChannel) CommChannel {
+	next.Force = next.Force || (e.Force && e.Status == next.Status)
+	return next
+}
+
+// New creates tenant controller. Results of the checker are served on /healthz and /readyz next to /metrics
+// on metricsAddr.
+func New(buffer int, watcherTimeout time.Duration, intakeCfg config.Intake, metricsAddr string, grpcServer *projects.Server,
+	checker *health.Checker) (*TenantController, error) {
+	comSig := make(chan CommChannel, buffer)
+	in, err := newIntake(comSig, intakeCfg)
+	if err != nil {
+		return nil, err
+	}
+
 	c, err := rest.InClusterConfig()
 	if err != nil {
 		return nil, fmt.Errorf("failed to read kubernetes service account token: %w", err)
@@ -55,9 +84,11 @@ func New(buffer int, watcherTimeout time.Duration, grpcServer *projects.Server)
 
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.Handler())
+	mux.Handle("/healthz", checker.LivenessHandler())
+	mux.Handle("/readyz", checker.ReadinessHandler())
 
 	server := &http.Server{
-		Addr:         ":9273",
+		Addr:         metricsAddr,
 		Handler:      mux,
 		ReadTimeout:  5 * time.Second,
 		WriteTimeout: 10 * time.Second,
@@ -65,49 +96,74 @@ func New(buffer int, watcherTimeout time.Duration, grpcServer *projects.Server)
 	}
 
 	return &TenantController{
-		ComSig:         make(chan CommChannel, buffer),
+		ComSig:         comSig,
 		client:         client,
 		server:         server,
 		watcherTimeout: watcherTimeout,
-		grpcServer:     *grpcServer,
+		intake:         in,
+		grpcServer:     grpcServer,
 	}, nil
 }
 
+// Start registers project callbacks keeping the ProjectService stream up to date and starts the metrics server.
+// Tenant actions are not sent to ComSig until StartLeading is called.
 func (tc *TenantController) Start() error {
-	if err := tc.addProjectWatcher(); err != nil {
-		return fmt.Errorf("failed to create project watcher: %w", err)
-	}
+	go tc.intake.run()
 
-	if _, err := tc.client.TenancyMultiTenancy().Runtime().Orgs("*").Folders("*").Projects("*").RegisterAddCallback(tc.addHandler); err != nil {
+	added, err := tc.client.TenancyMultiTenancy().Runtime().Orgs("*").Folders("*").Projects("*").RegisterAddCallback(tc.addHandler)
+	if err != nil {
 		return fmt.Errorf("unable to register project creation callback: %w", err)
 	}
 
-	if _, err := tc.client.TenancyMultiTenancy().Runtime().Orgs("*").Folders("*").Projects("*").RegisterUpdateCallback(tc.updateHandler); err != nil {
+	updated, err := tc.client.TenancyMultiTenancy().Runtime().Orgs("*").Folders("*").Projects("*").RegisterUpdateCallback(tc.updateHandler)
+	if err != nil {
 		return fmt.Errorf("unable to register project update callback: %w", err)
 	}
-
-	// Callback for project watcher deletion is safeguard for unintended project watcher deletion eg. during tenant controller update.
-	if _, err := tc.client.TenancyMultiTenancy().Config().ProjectWatchers(utility.AppName).RegisterDeleteCallback(tc.projectWatcherDeleteHandler); err != nil {
-		return fmt.Errorf("unable to register project watcher delete callback: %w", err)
-	}
+	tc.registrations = []cache.ResourceEventHandlerRegistration{added, updated}
 
 	go func() {
 		if err := tc.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
-			log.Printf("Prometheus server error: %v", err)
+			slog.Error("Prometheus server error", logging.Err(err))
 		}
 	}()
 
-	log.Print("Tenant controller starting")
+	slog.Info("Tenant controller starting")
 	return nil
 }
 
-func (tc *TenantController) Stop() {
-	log.Print("Tenant controller stopping")
-	tc.client.UnsubscribeAll()
+// StartLeading creates the project watcher and starts sending tenant actions to ComSig.
+func (tc *TenantController) StartLeading() error {
+	if err := tc.addProjectWatcher(); err != nil {
+		return fmt.Errorf("failed to create project watcher: %w", err)
+	}
+
+	// Callback for project watcher deletion is safeguard for unintended project watcher deletion eg. during tenant controller update.
+	if _, err := tc.client.TenancyMultiTenancy().Config().ProjectWatchers(utility.AppName).RegisterDeleteCallback(tc.projectWatcherDeleteHandler); err != nil {
+		return fmt.Errorf("unable to register project watcher delete callback: %w", err)
+	}
+
+	tc.leading.Store(true)
+	slog.Info("Tenant controller leading")
+	return nil
+}
+
+// StopLeading stops sending tenant actions to ComSig. The project watcher is deleted unless deleteWatcher is false,
+// e.g. when another replica takes over and keeps using it.
+func (tc *TenantController) StopLeading(deleteWatcher bool) {
+	tc.leading.Store(false)
+	if !deleteWatcher {
+		return
+	}
 
 	if err := tc.deleteProjectWatcher(); err != nil {
-		log.Printf("Failed to delete watcher: %v", err)
+		slog.Error("Failed to delete project watcher", logging.Err(err))
 	}
+}
+
+func (tc *TenantController) Stop() {
+	slog.Info("Tenant controller stopping")
+	tc.client.UnsubscribeAll()
+
 	stopped := make(chan struct{})
 	go func() {
 		tc.grpcServer.GrpcServer.GracefulStop()
@@ -118,7 +174,7 @@ func (tc *TenantController) Stop() {
 	t := time.NewTimer(dur)
 	select {
 	case <-t.C:
-		log.Printf("gRPC server did not stop in %v, stopping forcefully", dur)
+		slog.Warn("gRPC server did not stop in time, stopping forcefully", "timeout", dur)
 		tc.grpcServer.GrpcServer.Stop()
 	case <-stopped:
 		t.Stop()
@@ -127,21 +183,70 @@ func (tc *TenantController) Stop() {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	if err := tc.server.Shutdown(ctx); err != nil {
-		log.Printf("Prometheus server shutdown error: %v", err)
+		slog.Error("Prometheus server shutdown error", logging.Err(err))
 	}
 
+	tc.intake.close()
 	close(tc.ComSig)
 }
 
+// Synced reports whether project informers delivered all existing projects, so the project stream is complete.
+func (tc *TenantController) Synced(_ context.Context) error {
+	if len(tc.registrations) == 0 {
+		return errors.New("project callbacks not registered")
+	}
+	for _, r := range tc.registrations {
+		if !r.HasSynced() {
+			return errors.New("project informer not synced")
+		}
+	}
+	return nil
+}
+
+// WatcherRegistered reports whether the project watcher of the leading replica exists, so projects are not deleted
+// before tenants are cleaned up. It always succeeds on other replicas.
+func (tc *TenantController) WatcherRegistered(ctx context.Context) error {
+	if !tc.leading.Load() {
+		return nil
+	}
+	if _, err := tc.client.TenancyMultiTenancy().Config().GetProjectWatchers(ctx, utility.AppName); err != nil {
+		return fmt.Errorf("failed to get project watcher: %w", err)
+	}
+	return nil
+}
+
 func (a Action) String() string {
 	return [...]string{"InitializeTenant", "CleanupTenant"}[a]
 }
 
+// ParseAction returns action represented by the given string.
+func ParseAction(s string) (Action, error) {
+	for _, a := range []Action{InitializeTenant, CleanupTenant} {
+		if a.String() == s {
+			return a, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown action %q", s)
+}
+
+// GetProject returns project with given object name.
+func (tc *TenantController) GetProject(ctx context.Context, name string) (*nexus.RuntimeprojectRuntimeProject, error) {
+	return tc.client.Runtimeproject().GetRuntimeProjectByName(ctx, name)
+}
+
+// ListProjects returns all runtime projects.
+func (tc *TenantController) ListProjects(ctx context.Context) ([]*nexus.RuntimeprojectRuntimeProject, error) {
+	return tc.client.Runtimeproject().ListRuntimeProjects(ctx, metav1.ListOptions{})
+}
+
 // Callback for project watcher deletion is safeguard for unintended project watcher deletion eg. during tenant controller update.
 func (tc *TenantController) projectWatcherDeleteHandler(_ *nexus.ProjectwatcherProjectWatcher) {
+	if !tc.leading.Load() {
+		return
+	}
 	err := tc.addProjectWatcher()
 	if err != nil {
-		log.Print(err)
+		slog.Error("Failed to recreate project watcher", logging.Err(err))
 	}
 }
 
@@ -154,7 +259,7 @@ func (tc *TenantController) addProjectWatcher() error {
 	}})
 
 	if nexus.IsAlreadyExists(err) {
-		log.Print("Project watcher already exists")
+		slog.Info("Project watcher already exists")
 	} else if err != nil {
 		return err
 	}
@@ -168,7 +273,7 @@ func (tc *TenantController) deleteProjectWatcher() error {
 	err := tc.client.TenancyMultiTenancy().Config().DeleteProjectWatchers(ctx, utility.AppName)
 
 	if nexus.IsChildNotFound(err) {
-		log.Print("Project watcher already deleted")
+		slog.Info("Project watcher already deleted")
 	} else if err != nil {
 		return err
 	}
@@ -176,43 +281,58 @@ func (tc *TenantController) deleteProjectWatcher() error {
 }
 
 func (tc *TenantController) addHandler(project *nexus.RuntimeprojectRuntimeProject) {
-	log.Printf("Project %q added", project.UID)
+	slog.Info("Project added", logging.KeyTenantID, project.UID, logging.KeyProject, project.DisplayName(),
+		logging.KeyOrg, project.GetLabels()[utility.OrgNameLabel])
 	pd := projects.ProjectData{
 		ProjectName: project.DisplayName(),
 		OrgID:       project.GetLabels()[utility.OrgNameLabel],
 	}
 
 	if project.Spec.Deleted {
-		tc.ComSig <- CommChannel{project, CleanupTenant}
+		tc.send(CommChannel{Project: project, Status: CleanupTenant})
 		pd.Status = projects.ProjectDeleted
 	} else {
-		tc.ComSig <- CommChannel{project, InitializeTenant}
+		tc.send(CommChannel{Project: project, Status: InitializeTenant})
 		pd.Status = projects.ProjectCreated
 	}
 
-	tc.grpcServer.Mu.Lock()
-	tc.grpcServer.Projects[string(project.UID)] = pd
-	tc.grpcServer.Mu.Unlock()
-	tc.grpcServer.BroadcastUpdate()
+	tc.grpcServer.SetProject(string(project.UID), pd)
 }
 
-func (tc *TenantController) updateHandler(_, project *nexus.RuntimeprojectRuntimeProject) {
-	log.Printf("Project %q updated", project.UID)
+func (tc *TenantController) updateHandler(old, project *nexus.RuntimeprojectRuntimeProject) {
+	// Periodic resync delivers the very same object.
+	if old.ResourceVersion == project.ResourceVersion {
+		return
+	}
+
+	slog.Info("Project updated", logging.KeyTenantID, project.UID, logging.KeyProject, project.DisplayName(),
+		logging.KeyOrg, project.GetLabels()[utility.OrgNameLabel])
 	pd := projects.ProjectData{
 		ProjectName: project.DisplayName(),
 		OrgID:       project.GetLabels()[utility.OrgNameLabel],
 	}
 
+	// Updates of metadata or status only, e.g. label edits, do not change the desired state of the tenant.
+	specChanged := !reflect.DeepEqual(old.Spec, project.Spec)
 	if project.Spec.Deleted {
-		tc.ComSig <- CommChannel{project, CleanupTenant}
+		if specChanged {
+			tc.send(CommChannel{Project: project, Status: CleanupTenant})
+		}
 		pd.Status = projects.ProjectDeleted
 	} else {
-		tc.ComSig <- CommChannel{project, InitializeTenant}
+		if specChanged {
+			tc.send(CommChannel{Project: project, Status: InitializeTenant})
+		}
 		pd.Status = projects.ProjectCreated
 	}
 
-	tc.grpcServer.Mu.Lock()
-	tc.grpcServer.Projects[string(project.UID)] = pd
-	tc.grpcServer.Mu.Unlock()
-	tc.grpcServer.BroadcastUpdate()
+	tc.grpcServer.SetProject(string(project.UID), pd)
+}
+
+// send passes the action to the job manager of the leading replica without blocking the informer. Other replicas
+// drop it, the new leader enqueues actions for all projects once it takes over.
+func (tc *TenantController) send(event CommChannel) {
+	if tc.leading.Load() {
+		tc.intake.push(event)
+	}
 }
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCoalesce(t *testing.T) {
+	forced := func(action Action) CommChannel {
+		e := event("first", action)
+		e.Force = true
+		return e
+	}
+
+	tests := map[string]struct {
+		pending, next CommChannel
+		expected      CommChannel
+	}{
+		"Latest action kept": {
+			pending: event("first", InitializeTenant), next: event("first", CleanupTenant), expected: event("first", CleanupTenant),
+		},
+		"Forced restart kept for the same action": {
+			pending: forced(InitializeTenant), next: event("first", InitializeTenant), expected: forced(InitializeTenant),
+		},
+		"Forced restart dropped when action changes": {
+			pending: forced(InitializeTenant), next: event("first", CleanupTenant), expected: event("first", CleanupTenant),
+		},
+		"Forced restart of the latest event kept": {
+			pending: event("first", CleanupTenant), next: forced(InitializeTenant), expected: forced(InitializeTenant),
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, tt.expected, tt.pending.Coalesce(tt.next))
+		})
+	}
+}
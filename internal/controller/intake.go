@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
+)
+
+const (
+	intakeAccepted = "accepted"
+	intakeMerged   = "merged"
+	intakeDropped  = "dropped"
+)
+
+var intakeDepth = promauto.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "tenant_intake_queue_depth",
+		Help: "Number of tenants with project events waiting for the job manager",
+	},
+)
+
+var intakeLatency = promauto.NewHistogram(
+	prometheus.HistogramOpts{
+		Name:    "tenant_intake_enqueue_latency_seconds",
+		Help:    "Time from receiving a project event until it is enqueued to the job manager",
+		Buckets: prometheus.ExponentialBuckets(0.001, 4, 10),
+	},
+)
+
+var intakeEvents = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "tenant_intake_events_total",
+		Help: "Number of project events received from nexus callbacks by result",
+	}, []string{"result"},
+)
+
+type pendingEvent struct {
+	event      CommChannel
+	receivedAt time.Time
+}
+
+// intake decouples nexus callbacks from the job manager, so a burst of project events never blocks the informer.
+// A new event of a tenant with a pending one replaces it, as only the latest desired state matters - the queue never
+// holds more entries than there are projects. Events dropped by the overflow policy are recovered by reconciliation.
+type intake struct {
+	out      chan<- CommChannel
+	capacity int
+	overflow string
+
+	mu      sync.Mutex
+	cond    *sync.Cond
+	order   []types.UID
+	pending map[types.UID]pendingEvent
+	closed  bool
+	done    chan struct{}
+	stopped chan struct{}
+}
+
+func newIntake(out chan<- CommChannel, cfg config.Intake) (*intake, error) {
+	// Default policy is set by the config, unbounded intake never applies it.
+	switch cfg.Overflow {
+	case "":
+		if cfg.Capacity > 0 {
+			return nil, errors.New("intake overflow policy is required with capacity")
+		}
+	case config.OverflowDropNewest, config.OverflowDropOldest, config.OverflowBlock:
+	default:
+		return nil, fmt.Errorf("unknown intake overflow policy %q", cfg.Overflow)
+	}
+
+	i := &intake{
+		out:      out,
+		capacity: cfg.Capacity,
+		overflow: cfg.Overflow,
+		pending:  map[types.UID]pendingEvent{},
+		done:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+	i.cond = sync.NewCond(&i.mu)
+	return i, nil
+}
+
+// push adds the event without waiting for the job manager. It blocks only with block overflow policy at capacity.
+func (i *intake) push(event CommChannel) {
+	id := event.Project.UID
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if p, ok := i.pending[id]; ok {
+		// Merged event keeps its place in the queue and the time the tenant started waiting.
+		i.pending[id] = pendingEvent{event: p.event.Coalesce(event), receivedAt: p.receivedAt}
+		intakeEvents.WithLabelValues(intakeMerged).Inc()
+		return
+	}
+
+	for i.capacity > 0 && len(i.order) >= i.capacity && !i.closed {
+		switch i.overflow {
+		case config.OverflowDropNewest:
+			slog.Warn("Intake queue full - dropping event", logging.KeyTenantID, id, logging.KeyAction, event.Status.String())
+			intakeEvents.WithLabelValues(intakeDropped).Inc()
+			return
+		case config.OverflowDropOldest:
+			oldest := i.order[0]
+			slog.Warn("Intake queue full - dropping event", logging.KeyTenantID, oldest, logging.KeyAction, i.pending[oldest].event.Status.String())
+			delete(i.pending, oldest)
+			i.order = i.order[1:]
+			intakeEvents.WithLabelValues(intakeDropped).Inc()
+		case config.OverflowBlock:
+			i.cond.Wait()
+		}
+	}
+	if i.closed {
+		return
+	}
+
+	i.pending[id] = pendingEvent{event: event, receivedAt: time.Now()}
+	i.order = append(i.order, id)
+	intakeEvents.WithLabelValues(intakeAccepted).Inc()
+	intakeDepth.Set(float64(len(i.order)))
+	i.cond.Broadcast()
+}
+
+// run forwards events to the job manager in order of arrival until the intake is closed.
+func (i *intake) run() {
+	defer close(i.stopped)
+	for {
+		i.mu.Lock()
+		for len(i.order) == 0 && !i.closed {
+			i.cond.Wait()
+		}
+		if i.closed {
+			i.mu.Unlock()
+			return
+		}
+		id := i.order[0]
+		p := i.pending[id]
+		i.order = i.order[1:]
+		delete(i.pending, id)
+		intakeDepth.Set(float64(len(i.order)))
+		// Producers blocked at capacity can proceed.
+		i.cond.Broadcast()
+		i.mu.Unlock()
+
+		if !i.forward(p.event) {
+			return
+		}
+		intakeLatency.Observe(time.Since(p.receivedAt).Seconds())
+	}
+}
+
+// forward hands the event over to the job manager. It gives up once the intake is closed.
+func (i *intake) forward(event CommChannel) bool {
+	select {
+	case i.out <- event:
+		return true
+	case <-i.done:
+		return false
+	}
+}
+
+// close stops forwarding started by run and waits until it returns. Pending events are discarded.
+func (i *intake) close() {
+	i.mu.Lock()
+	if !i.closed {
+		i.closed = true
+		close(i.done)
+	}
+	i.cond.Broadcast()
+	i.mu.Unlock()
+	<-i.stopped
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtimeproject.edge-orchestrator.intel.com/v1"
+	nexus "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+)
+
+func event(id string, action Action) CommChannel {
+	return CommChannel{
+		Project: &nexus.RuntimeprojectRuntimeProject{RuntimeProject: &projectv1.RuntimeProject{ObjectMeta: metav1.ObjectMeta{UID: types.UID(id)}}},
+		Status:  action,
+	}
+}
+
+func receive(t *testing.T, out <-chan CommChannel) CommChannel {
+	t.Helper()
+	select {
+	case e := <-out:
+		return e
+	case <-time.After(time.Second):
+		require.Fail(t, "Event not forwarded")
+	}
+	return CommChannel{}
+}
+
+func TestIntake(t *testing.T) {
+	t.Run("Events of a tenant merged", func(t *testing.T) {
+		out := make(chan CommChannel, 10)
+		in, err := newIntake(out, config.Intake{})
+		require.NoError(t, err)
+
+		mergedBefore := testutil.ToFloat64(intakeEvents.WithLabelValues(intakeMerged))
+		in.push(event("first", InitializeTenant))
+		in.push(event("second", InitializeTenant))
+		in.push(event("first", CleanupTenant))
+		require.InDelta(t, mergedBefore+1, testutil.ToFloat64(intakeEvents.WithLabelValues(intakeMerged)), 0)
+
+		go in.run()
+		defer in.close()
+
+		first := receive(t, out)
+		require.Equal(t, types.UID("first"), first.Project.UID, "Merged event lost its place in the queue")
+		require.Equal(t, CleanupTenant, first.Status, "Latest event not kept")
+		require.Equal(t, types.UID("second"), receive(t, out).Project.UID)
+	})
+
+	tests := []struct {
+		overflow string
+		expected types.UID
+	}{
+		{overflow: config.OverflowDropNewest, expected: "first"},
+		{overflow: config.OverflowDropOldest, expected: "second"},
+	}
+	for _, tt := range tests {
+		t.Run("Overflow policy "+tt.overflow, func(t *testing.T) {
+			out := make(chan CommChannel, 10)
+			in, err := newIntake(out, config.Intake{Capacity: 1, Overflow: tt.overflow})
+			require.NoError(t, err)
+
+			droppedBefore := testutil.ToFloat64(intakeEvents.WithLabelValues(intakeDropped))
+			in.push(event("first", InitializeTenant))
+			in.push(event("second", InitializeTenant))
+			require.InDelta(t, droppedBefore+1, testutil.ToFloat64(intakeEvents.WithLabelValues(intakeDropped)), 0)
+
+			go in.run()
+			defer in.close()
+			require.Equal(t, tt.expected, receive(t, out).Project.UID)
+		})
+	}
+
+	t.Run("Overflow policy block", func(t *testing.T) {
+		out := make(chan CommChannel)
+		in, err := newIntake(out, config.Intake{Capacity: 1, Overflow: config.OverflowBlock})
+		require.NoError(t, err)
+
+		in.push(event("first", InitializeTenant))
+		pushed := make(chan struct{})
+		go func() {
+			in.push(event("second", InitializeTenant))
+			close(pushed)
+		}()
+
+		select {
+		case <-pushed:
+			require.Fail(t, "Push not blocked at capacity")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		go in.run()
+		defer in.close()
+		require.Equal(t, types.UID("first"), receive(t, out).Project.UID)
+		<-pushed
+		require.Equal(t, types.UID("second"), receive(t, out).Project.UID)
+	})
+
+	t.Run("Closed while job manager does not receive", func(t *testing.T) {
+		in, err := newIntake(make(chan CommChannel), config.Intake{})
+		require.NoError(t, err)
+		go in.run()
+
+		in.push(event("first", InitializeTenant))
+		in.close()
+		in.push(event("second", InitializeTenant))
+	})
+
+	t.Run("Unknown overflow policy - error expected", func(t *testing.T) {
+		_, err := newIntake(make(chan CommChannel), config.Intake{Overflow: "foo"})
+		require.ErrorContains(t, err, "unknown intake overflow policy")
+	})
+
+	t.Run("Capacity without overflow policy - error expected", func(t *testing.T) {
+		_, err := newIntake(make(chan CommChannel), config.Intake{Capacity: 1})
+		require.ErrorContains(t, err, "intake overflow policy is required")
+	})
+}
@@ -0,0 +1,255 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
+)
+
+const defaultTimeout = 5 * time.Second
+
+var dependencyUp = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "controller_dependency_up",
+		Help: "Whether the last check of the dependency succeeded",
+	}, []string{"dependency"},
+)
+
+// Check reports a problem of a controller component by returning an error.
+type Check func(ctx context.Context) error
+
+// Checker runs liveness and readiness checks of the controller components. Failing liveness check means the controller
+// has to be restarted, failing readiness check means it is not able to serve requests at the moment. Dependency checks
+// cover services the controller relies on for some of its work only, their failures are reported without affecting
+// readiness.
+type Checker struct {
+	timeout time.Duration
+
+	mu           sync.RWMutex
+	liveness     map[string]Check
+	readiness    map[string]Check
+	dependencies map[string]Check
+}
+
+// New creates checker running every check with the timeout. Default timeout is used when it is not positive.
+func New(timeout time.Duration) *Checker {
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	return &Checker{
+		timeout:      timeout,
+		liveness:     map[string]Check{},
+		readiness:    map[string]Check{},
+		dependencies: map[string]Check{},
+	}
+}
+
+// AddLiveness adds the check to liveness checks. Liveness checks are part of readiness as well.
+func (c *Checker) AddLiveness(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.liveness[name] = check
+}
+
+// AddReadiness adds the check to readiness checks.
+func (c *Checker) AddReadiness(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.readiness[name] = check
+}
+
+// AddDependency adds the check to dependency checks.
+func (c *Checker) AddDependency(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dependencies[name] = check
+}
+
+// Live runs liveness checks and returns the failures keyed by check name.
+func (c *Checker) Live(ctx context.Context) map[string]error {
+	c.mu.RLock()
+	checks := make(map[string]Check, len(c.liveness))
+	for name, check := range c.liveness {
+		checks[name] = check
+	}
+	c.mu.RUnlock()
+	return c.run(ctx, checks)
+}
+
+// Ready runs liveness and readiness checks and returns the failures keyed by check name.
+func (c *Checker) Ready(ctx context.Context) map[string]error {
+	c.mu.RLock()
+	checks := make(map[string]Check, len(c.liveness)+len(c.readiness))
+	for name, check := range c.liveness {
+		checks[name] = check
+	}
+	for name, check := range c.readiness {
+		checks[name] = check
+	}
+	c.mu.RUnlock()
+	return c.run(ctx, checks)
+}
+
+// Dependencies runs dependency checks, reports their result by the controller_dependency_up metric and returns the
+// failures keyed by check name.
+func (c *Checker) Dependencies(ctx context.Context) map[string]error {
+	c.mu.RLock()
+	checks := make(map[string]Check, len(c.dependencies))
+	for name, check := range c.dependencies {
+		checks[name] = check
+	}
+	c.mu.RUnlock()
+
+	failures := c.run(ctx, checks)
+	for name := range checks {
+		up := 1.0
+		if _, failed := failures[name]; failed {
+			up = 0
+		}
+		dependencyUp.WithLabelValues(name).Set(up)
+	}
+	return failures
+}
+
+func (c *Checker) run(ctx context.Context, checks map[string]Check) map[string]error {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	failures := map[string]error{}
+	for name, check := range checks {
+		wg.Go(func() {
+			if err := check(ctx); err != nil {
+				mu.Lock()
+				failures[name] = err
+				mu.Unlock()
+			}
+		})
+	}
+	wg.Wait()
+	return failures
+}
+
+// LivenessHandler serves result of liveness checks, responding with 503 when any of them fails.
+func (c *Checker) LivenessHandler() http.Handler {
+	return c.handler(c.Live, nil)
+}
+
+// ReadinessHandler serves result of readiness checks, responding with 503 when any of them fails. Results of dependency
+// checks are listed as well, failing ones marked with [!] without affecting the response code.
+func (c *Checker) ReadinessHandler() http.Handler {
+	return c.handler(c.Ready, c.Dependencies)
+}
+
+func (c *Checker) handler(run, dependencies func(context.Context) map[string]error) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		failures := run(r.Context())
+		results := map[string]string{}
+		for name, err := range failures {
+			results[name] = fmt.Sprintf("[-]%v failed: %v", name, err)
+		}
+		if dependencies != nil {
+			for name, err := range dependencies(r.Context()) {
+				results[name] = fmt.Sprintf("[!]%v failed: %v", name, err)
+			}
+		}
+
+		var b strings.Builder
+		for _, name := range c.names(dependencies != nil) {
+			if result, ok := results[name]; ok {
+				fmt.Fprintln(&b, result)
+			} else {
+				fmt.Fprintf(&b, "[+]%v ok\n", name)
+			}
+		}
+
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		if len(failures) != 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		_, _ = w.Write([]byte(b.String()))
+	})
+}
+
+// names returns names of liveness checks, including the ones of readiness and dependency checks when ready is set.
+func (c *Checker) names(ready bool) []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if !ready {
+		return sortedNames(c.liveness)
+	}
+	return sortedNames(c.liveness, c.readiness, c.dependencies)
+}
+
+func sortedNames(checks ...map[string]Check) []string {
+	var names []string
+	for _, m := range checks {
+		for name := range m {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Serve keeps serving status of the services in the gRPC health server in line with readiness until ctx is done.
+// Overall status of the server is reported under the empty service name. Dependencies are checked along, keeping their
+// metric up to date.
+func (c *Checker) Serve(ctx context.Context, server *grpchealth.Server, interval time.Duration, services ...string) {
+	update := func() {
+		status := healthpb.HealthCheckResponse_SERVING
+		if failures := c.Ready(ctx); len(failures) != 0 {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+			slog.Debug("Controller not ready", logging.Err(joinFailures(failures)))
+		}
+		if failures := c.Dependencies(ctx); len(failures) != 0 {
+			slog.Debug("Controller dependencies unavailable", logging.Err(joinFailures(failures)))
+		}
+		for _, service := range append([]string{""}, services...) {
+			server.SetServingStatus(service, status)
+		}
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		update()
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func joinFailures(failures map[string]error) error {
+	names := make([]string, 0, len(failures))
+	for name := range failures {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	errs := make([]error, 0, len(failures))
+	for _, name := range names {
+		errs = append(errs, fmt.Errorf("%v: %w", name, failures[name]))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestHandlers(t *testing.T) {
+	var ready atomic.Bool
+	checker := New(time.Second)
+	checker.AddLiveness("loop", func(context.Context) error { return nil })
+	checker.AddReadiness("informers", func(context.Context) error {
+		if !ready.Load() {
+			return errors.New("not synced")
+		}
+		return nil
+	})
+
+	get := func(h http.Handler) (int, string) {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		body, err := io.ReadAll(rec.Result().Body)
+		require.NoError(t, err)
+		return rec.Code, string(body)
+	}
+
+	code, body := get(checker.LivenessHandler())
+	require.Equal(t, http.StatusOK, code, "Liveness must not depend on readiness checks")
+	require.Equal(t, "[+]loop ok\n", body)
+
+	code, body = get(checker.ReadinessHandler())
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, "[-]informers failed: not synced\n[+]loop ok\n", body)
+
+	ready.Store(true)
+	code, body = get(checker.ReadinessHandler())
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, "[+]informers ok\n[+]loop ok\n", body)
+}
+
+func TestDependencies(t *testing.T) {
+	checker := New(time.Second)
+	checker.AddReadiness("informers", func(context.Context) error { return nil })
+	checker.AddDependency("loki", func(context.Context) error { return errors.New("unreachable") })
+	checker.AddDependency("mimir", func(context.Context) error { return nil })
+
+	rec := httptest.NewRecorder()
+	checker.ReadinessHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	body, err := io.ReadAll(rec.Result().Body)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code, "Readiness must not depend on dependency checks")
+	require.Equal(t, "[+]informers ok\n[!]loki failed: unreachable\n[+]mimir ok\n", string(body))
+	require.InDelta(t, 0, testutil.ToFloat64(dependencyUp.WithLabelValues("loki")), 0)
+	require.InDelta(t, 1, testutil.ToFloat64(dependencyUp.WithLabelValues("mimir")), 0)
+}
+
+func TestCheckTimeout(t *testing.T) {
+	checker := New(10 * time.Millisecond)
+	checker.AddReadiness("slow", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	failures := checker.Ready(t.Context())
+	require.ErrorIs(t, failures["slow"], context.DeadlineExceeded)
+}
+
+func TestServe(t *testing.T) {
+	var ready atomic.Bool
+	checker := New(time.Second)
+	checker.AddReadiness("backend", func(context.Context) error {
+		if !ready.Load() {
+			return errors.New("unreachable")
+		}
+		return nil
+	})
+
+	server := grpchealth.NewServer()
+	ctx, cancel := context.WithCancel(t.Context())
+	done := make(chan struct{})
+	go func() {
+		checker.Serve(ctx, server, 10*time.Millisecond, "service")
+		close(done)
+	}()
+
+	status := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		res, err := server.Check(t.Context(), &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			return healthpb.HealthCheckResponse_UNKNOWN
+		}
+		return res.GetStatus()
+	}
+
+	require.Eventually(t, func() bool {
+		return status("") == healthpb.HealthCheckResponse_NOT_SERVING && status("service") == healthpb.HealthCheckResponse_NOT_SERVING
+	}, time.Second, 10*time.Millisecond, "Services should not be serving")
+
+	ready.Store(true)
+	require.Eventually(t, func() bool {
+		return status("") == healthpb.HealthCheckResponse_SERVING && status("service") == healthpb.HealthCheckResponse_SERVING
+	}, time.Second, 10*time.Millisecond, "Services should be serving")
+
+	cancel()
+	<-done
+}
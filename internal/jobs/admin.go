@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package jobs
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
+)
+
+var (
+	ErrJobNotFound   = errors.New("job not found")
+	ErrJobFinished   = errors.New("job already finished")
+	ErrJobNotRunning = errors.New("job not running")
+)
+
+// JobInfo is a point-in-time view of a tenant job.
+type JobInfo struct {
+	TenantID    string
+	ProjectName string
+	OrgName     string
+	Action      string
+	Status      string
+	Attempts    int
+	LastError   string
+	Steps       []StepInfo
+	// DeleteAt is the end of the grace period of a cleanup. It is zero for other actions.
+	DeleteAt time.Time
+	// Archive is location of tenant data exported by the cleanup.
+	Archive string
+	// Orphan is set for cleanup of a tenant whose project no longer exists.
+	Orphan bool
+}
+
+// StepInfo is a state of a job step executed by a single backend.
+type StepInfo struct {
+	Backend string
+	State   string
+}
+
+func (s jobStatus) String() string {
+	switch s {
+	case jobCreated:
+		return "created"
+	case jobInProgress:
+		return "in_progress"
+	case jobCancelled:
+		return "cancelled"
+	case tenantCreated:
+		return "tenant_created"
+	case tenantDeleted:
+		return "tenant_deleted"
+	case tenantIDsNotMatch:
+		return "tenant_ids_not_match"
+	case jobFailed:
+		return "failed"
+	case tenantPendingDeletion:
+		return "pending_deletion"
+	}
+	return "unknown"
+}
+
+// finished reports whether the job has nothing left to do.
+func (s jobStatus) finished() bool {
+	return s == tenantCreated || s == tenantDeleted || s == tenantIDsNotMatch
+}
+
+// Jobs returns all jobs known to the manager sorted by tenantID.
+func (jm *JobManager) Jobs() []JobInfo {
+	jm.mu.RLock()
+	defer jm.mu.RUnlock()
+
+	infos := make([]JobInfo, 0, len(jm.jobList))
+	for _, j := range jm.jobList {
+		infos = append(infos, j.info())
+	}
+	sort.Slice(infos, func(i, k int) bool {
+		return infos[i].TenantID < infos[k].TenantID
+	})
+	return infos
+}
+
+// Job returns the job of the tenant.
+func (jm *JobManager) Job(tenantID string) (JobInfo, error) {
+	jm.mu.RLock()
+	defer jm.mu.RUnlock()
+
+	j, ok := jm.jobList[types.UID(tenantID)]
+	if !ok {
+		return JobInfo{}, fmt.Errorf("%w: tenantID %q", ErrJobNotFound, tenantID)
+	}
+	return j.info(), nil
+}
+
+// RetryJob restarts the job of the tenant immediately, skipping the remaining backoff. Failed job gets a new retry budget.
+// Steps already completed within the current action are not executed again.
+func (jm *JobManager) RetryJob(tenantID string) (JobInfo, error) {
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+
+	j, ok := jm.jobList[types.UID(tenantID)]
+	if !ok {
+		return JobInfo{}, fmt.Errorf("%w: tenantID %q", ErrJobNotFound, tenantID)
+	}
+	if jobStatus(j.status.Load()).finished() {
+		return JobInfo{}, fmt.Errorf("%w: tenantID %q", ErrJobFinished, tenantID)
+	}
+
+	j.cancel()
+	j.mu.Lock()
+	action := j.action
+	j.mu.Unlock()
+
+	slog.Info("Retrying job on request", logging.KeyTenantID, tenantID, logging.KeyAction, action.String())
+	j.run(jm.ctx, action)
+	return j.info(), nil
+}
+
+// CancelJob stops the job of the tenant and removes it from the store, so it is not resumed on controller restart.
+// The job runs again on the next project event.
+func (jm *JobManager) CancelJob(tenantID string) (JobInfo, error) {
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+
+	j, ok := jm.jobList[types.UID(tenantID)]
+	if !ok {
+		return JobInfo{}, fmt.Errorf("%w: tenantID %q", ErrJobNotFound, tenantID)
+	}
+	if status := jobStatus(j.status.Load()); status != jobInProgress && status != tenantPendingDeletion {
+		return JobInfo{}, fmt.Errorf("%w: tenantID %q", ErrJobNotRunning, tenantID)
+	}
+
+	slog.Info("Cancelling job on request", logging.KeyTenantID, tenantID)
+	j.cancel()
+	jm.deleteRecord(tenantID)
+	return j.info(), nil
+}
+
+func (j *job) info() JobInfo {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	info := JobInfo{
+		TenantID:    j.tenantID,
+		ProjectName: j.projectName,
+		OrgName:     j.orgName,
+		Action:      j.action.String(),
+		Status:      jobStatus(j.status.Load()).String(),
+		Attempts:    j.attempts,
+		Steps:       make([]StepInfo, 0, len(j.backends)),
+		DeleteAt:    j.deleteAt,
+		Archive:     j.archive,
+		Orphan:      j.orphan,
+	}
+	if j.lastErr != nil {
+		info.LastError = j.lastErr.Error()
+	}
+	for _, name := range j.stepNames(j.action) {
+		state, ok := j.stepStates[name]
+		if !ok {
+			state = stepPending
+		}
+		info.Steps = append(info.Steps, StepInfo{Backend: name, State: string(state)})
+	}
+	return info
+}
@@ -7,34 +7,51 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"math"
+	"slices"
+	"sync"
 	"sync/atomic"
 	"time"
 
-	amproto "github.com/open-edge-platform/o11y-alerting-monitor/api/v1/management"
-	sreproto "github.com/open-edge-platform/o11y-sre-exporter/api/config-reloader"
 	projectwatchv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectactivewatcher.edge-orchestrator.intel.com/v1"
 	nexus "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
-	"golang.org/x/sync/errgroup"
-	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/types"
 
-	"github.com/open-edge-platform/o11y-tenant-controller/internal/alertingmonitor"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/archive"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/backend"
 	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
 	"github.com/open-edge-platform/o11y-tenant-controller/internal/controller"
-	"github.com/open-edge-platform/o11y-tenant-controller/internal/loki"
-	"github.com/open-edge-platform/o11y-tenant-controller/internal/mimir"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
 	"github.com/open-edge-platform/o11y-tenant-controller/internal/projects"
-	"github.com/open-edge-platform/o11y-tenant-controller/internal/sre"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/store"
 	"github.com/open-edge-platform/o11y-tenant-controller/internal/util"
 	"github.com/open-edge-platform/o11y-tenant-controller/internal/watcher"
 )
 
 type jobStatus int
 
+// ProjectResolver looks project up by its object name. It is used to resume persisted jobs.
+type ProjectResolver func(ctx context.Context, name string) (*nexus.RuntimeprojectRuntimeProject, error)
+
+// Archiver exports tenant data before it is purged by the cleanup and returns location of the archive. Export resumes
+// from progress, checkpoint is called once the next part of the data is exported.
+type Archiver interface {
+	Archive(ctx context.Context, tenantID string, progress archive.Progress, checkpoint func(archive.Progress)) (string, error)
+}
+
+// archiveStep is the step exporting tenant data, executed before the backend steps of the cleanup.
+const archiveStep = "archive"
+
+// storeTimeout bounds every job store operation, so a slow store cannot stall the jobs.
+const storeTimeout = 10 * time.Second
+
+// stuckTimeout is how long processing of a single project event may take before the job loop is reported as not alive.
+// Processing waits for the previous job of the tenant to stop and persists the new one.
+const stuckTimeout = 2 * time.Minute
+
 var projectIDs = promauto.NewGaugeVec(
 	prometheus.GaugeOpts{
 		Name: "project_metadata",
@@ -42,6 +59,29 @@ var projectIDs = promauto.NewGaugeVec(
 	}, []string{"projectId", "projectName", "orgName", "status"},
 )
 
+var jobSteps = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "tenant_job_step_state",
+		Help: "Exposes state of tenant job steps - one step per backend",
+	}, []string{"projectId", "action", "backend", "state"},
+)
+
+var jobFailures = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "tenant_job_failed",
+		Help: "Set for tenant jobs that exhausted their retries and require operator intervention",
+	}, []string{"projectId", "action"},
+)
+
+type stepState string
+
+const (
+	stepPending   stepState = "pending"
+	stepRunning   stepState = "running"
+	stepCompleted stepState = "completed"
+	stepFailed    stepState = "failed"
+)
+
 const (
 	jobCreated jobStatus = iota
 	jobInProgress
@@ -49,59 +89,111 @@ const (
 	tenantCreated
 	tenantDeleted
 	tenantIDsNotMatch
+	jobFailed
+	tenantPendingDeletion
 )
 
 type JobManager struct {
-	comSig       chan controller.CommChannel
-	jobList      map[types.UID]*job
-	jobCfg       config.Job
-	endpointsCfg config.Endpoints
-	cancelFn     context.CancelFunc
-	done         chan struct{}
-
-	amClient  amproto.ManagementClient
-	sreClient sreproto.ManagementClient
+	comSig   chan controller.CommChannel
+	jobList  map[types.UID]*job
+	jobCfg   config.Job
+	backends []backend.TenantBackend
+	store    store.Store
+	archiver Archiver
+	resolve  ProjectResolver
+	ctx      context.Context
+	cancelFn context.CancelFunc
+	done     chan struct{}
+	queue    *tenantQueue
+	ticker   *time.Ticker
+	// busySince is the start of processing of the current event in unix nanoseconds, zero when the worker is idle.
+	busySince atomic.Int64
+
+	// mu guards jobList and serializes starting and cancelling jobs.
+	mu sync.RWMutex
 }
 
 type job struct {
-	project      *nexus.RuntimeprojectRuntimeProject
-	status       atomic.Int32
-	jobCfg       config.Job
-	endpointsCfg config.Endpoints
-	cancelFn     context.CancelFunc
-
-	amClient  amproto.ManagementClient
-	sreClient sreproto.ManagementClient
+	project  *nexus.RuntimeprojectRuntimeProject
+	status   atomic.Int32
+	jobCfg   config.Job
+	backends []backend.TenantBackend
+	store    store.Store
+	archiver Archiver
+	cancelFn context.CancelFunc
+	stopped  chan struct{}
+
+	// persistMu orders writes of the job state, so an older state never overwrites a newer one in the store.
+	persistMu sync.Mutex
+	// mu guards the persisted and reported part of job state.
+	mu             sync.Mutex
+	action         controller.Action
+	attempts       int
+	completedSteps []string
+	startedAt      time.Time
+	deleteAt       time.Time
+	archive        string
+	archiveTo      time.Time
+	archiveWindows int
+	lastErr        error
+	stepStates     map[string]stepState
+	// orphan is set for a tenant whose project no longer exists, so there is no watcher to report on.
+	orphan bool
+	// Project labels are cached, as the project object is modified in place by the nexus client while the job runs.
+	tenantID    string
+	projectName string
+	orgName     string
 }
 
-func New(channel chan controller.CommChannel, jCfg config.Job, endpoints config.Endpoints, amConn, sreConn *grpc.ClientConn) *JobManager {
+// New creates job manager. Archiver is optional - tenant data is not archived before cleanup when it is nil.
+func New(channel chan controller.CommChannel, jCfg config.Job, backends []backend.TenantBackend, st store.Store, archiver Archiver,
+	resolve ProjectResolver) *JobManager {
 	return &JobManager{
-		comSig:       channel,
-		jobList:      map[types.UID]*job{},
-		jobCfg:       jCfg,
-		endpointsCfg: endpoints,
-		done:         make(chan struct{}),
-		amClient:     amproto.NewManagementClient(amConn),
-		sreClient:    sreproto.NewManagementClient(sreConn),
+		comSig:   channel,
+		jobList:  map[types.UID]*job{},
+		jobCfg:   jCfg,
+		backends: backends,
+		store:    st,
+		archiver: archiver,
+		resolve:  resolve,
+		done:     make(chan struct{}),
+		queue:    newTenantQueue(),
 	}
 }
 
+// Start resumes jobs persisted in the store and starts processing project events.
 func (jm *JobManager) Start(ticker *time.Ticker) {
 	ctx, cancel := context.WithCancel(context.Background())
+	jm.mu.Lock()
+	jm.ctx = ctx
+	jm.ticker = ticker
+	jm.mu.Unlock()
 	jm.cancelFn = cancel
 	go func() {
+		for {
+			event, done, ok := jm.queue.get()
+			if !ok {
+				return
+			}
+			jm.busySince.Store(time.Now().UnixNano())
+			jm.startJob(ctx, event)
+			jm.busySince.Store(0)
+			done()
+		}
+	}()
+	go func() {
+		jm.resume(ctx)
 		for {
 			select {
 			case <-jm.done:
 				return
-			case v := <-jm.comSig:
-				switch v.Status {
-				case controller.InitializeTenant:
-					jm.startJob(ctx, v.Project, controller.InitializeTenant)
-				case controller.CleanupTenant:
-					jm.startJob(ctx, v.Project, controller.CleanupTenant)
+			case v, ok := <-jm.comSig:
+				if !ok {
+					return
 				}
+				jm.queue.add(v)
 			case <-ticker.C:
+				jm.mu.Lock()
 				for k, job := range jm.jobList {
 					status := jobStatus(job.status.Load())
 					//nolint:staticcheck // Linter suggests: "QF1003: could use tagged switch on status"
@@ -109,12 +201,17 @@ func (jm *JobManager) Start(ticker *time.Ticker) {
 					// that there are missing cases in switch of iota type jobs.jobStatus
 					if status == tenantDeleted {
 						removeProjectMetadata(job.project)
+						removeStepStates(string(k))
+						removeJobFailure(string(k))
 						delete(jm.jobList, k)
 					} else if status == tenantIDsNotMatch {
 						removeProjectMetadataByID(string(k))
+						removeStepStates(string(k))
+						removeJobFailure(string(k))
 						delete(jm.jobList, k)
 					}
 				}
+				jm.mu.Unlock()
 			}
 		}
 	}()
@@ -124,106 +221,619 @@ func (jm *JobManager) Stop() {
 	if jm.cancelFn != nil {
 		jm.cancelFn()
 	}
+	// Ticker is stopped by the caller and must not be restarted by a config reload.
+	jm.mu.Lock()
+	jm.ticker = nil
+	jm.mu.Unlock()
+	jm.queue.shutDown()
 	close(jm.done)
 }
 
-func (jm *JobManager) startJob(ctx context.Context, project *nexus.RuntimeprojectRuntimeProject, action controller.Action) {
-	setProjectMetadata(project, action)
+// UpdateConfig applies reloaded configuration to the manager and all of its jobs without interrupting them. Backoff,
+// retry limits and timeout take effect on the next attempt of running jobs. Store, archive and sre settings are kept,
+// as their components are created on start.
+func (jm *JobManager) UpdateConfig(jCfg config.Job) {
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+
+	jCfg.Store, jCfg.Archive, jCfg.Sre = jm.jobCfg.Store, jm.jobCfg.Archive, jm.jobCfg.Sre
+	rateChanged := jCfg.Manager.Deletion.Rate != jm.jobCfg.Manager.Deletion.Rate
+	jm.jobCfg = jCfg
+	for _, j := range jm.jobList {
+		j.mu.Lock()
+		j.jobCfg = jCfg
+		j.mu.Unlock()
+	}
+	if rateChanged && jm.ticker != nil {
+		jm.ticker.Reset(jCfg.Manager.Deletion.Rate)
+	}
+}
+
+// Alive reports whether the worker processing project events is not stuck, e.g. waiting for a job that ignores
+// cancellation. Idle worker of a replica that is not leading is alive.
+func (jm *JobManager) Alive(_ context.Context) error {
+	since := jm.busySince.Load()
+	if since == 0 {
+		return nil
+	}
+	if busy := time.Since(time.Unix(0, since)); busy > stuckTimeout {
+		return fmt.Errorf("job loop stuck processing an event for %v", busy.Round(time.Second))
+	}
+	return nil
+}
+
+// startJob runs the action of the event. Job already running or done with the same action is left intact,
+// unless restart is forced.
+func (jm *JobManager) startJob(ctx context.Context, event controller.CommChannel) {
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+
+	project, action := event.Project, event.Status
 	job, exists := jm.jobList[project.UID]
+	if exists && !event.Force && !job.needsRestart(action) {
+		slog.Debug("Action already in place - skipping", logging.KeyTenantID, project.UID, logging.KeyAction, action.String())
+		jobEvents.WithLabelValues(eventSkipped).Inc()
+		return
+	}
+
+	jobEvents.WithLabelValues(eventStarted).Inc()
+	setProjectMetadata(project, action)
 	if exists {
 		job.cancel()
+		job.setProject(project)
 		job.run(ctx, action)
 	} else {
-		job = newJob(project, jm.jobCfg, jm.endpointsCfg, jm.amClient, jm.sreClient)
+		job = newJob(project, jm.jobCfg, jm.backends, jm.store, jm.archiver)
+		jm.jobList[project.UID] = job
+		job.run(ctx, action)
+	}
+}
+
+// resume restarts unfinished jobs recorded in the store, e.g. by a previous controller instance.
+func (jm *JobManager) resume(ctx context.Context) {
+	storeCtx, cancel := context.WithTimeout(ctx, storeTimeout)
+	records, err := jm.store.List(storeCtx)
+	cancel()
+	if err != nil {
+		slog.Error("Failed to load persisted jobs", logging.Err(err))
+		return
+	}
+
+	for _, r := range records {
+		action, err := controller.ParseAction(r.Action)
+		if err != nil {
+			slog.Warn("Dropping persisted job", logging.KeyTenantID, r.TenantID, logging.Err(err))
+			jm.deleteRecord(r.TenantID)
+			continue
+		}
+
+		var project *nexus.RuntimeprojectRuntimeProject
+		if r.Orphan {
+			project = orphanProject(r.TenantID)
+		} else {
+			project, err = jm.resolve(ctx, r.Name)
+		}
+		if nexus.IsNotFound(err) || (err == nil && string(project.UID) != r.TenantID) {
+			slog.Info("Dropping persisted job - project no longer exists", logging.KeyTenantID, r.TenantID, logging.KeyAction, action.String())
+			jm.deleteRecord(r.TenantID)
+			continue
+		} else if err != nil {
+			slog.Error("Failed to resume job", logging.KeyTenantID, r.TenantID, logging.KeyAction, action.String(), logging.Err(err))
+			continue
+		}
+
+		jm.mu.Lock()
+		if _, exists := jm.jobList[project.UID]; exists {
+			// Job started meanwhile, e.g. for an orphaned tenant, already carries the recorded state.
+			jm.mu.Unlock()
+			continue
+		}
+		setProjectMetadata(project, action)
+		job := newJob(project, jm.jobCfg, jm.backends, jm.store, jm.archiver)
+		job.logger(action).Info("Resuming job", "failedAttempts", r.Attempts)
+		job.restore(action, r)
 		jm.jobList[project.UID] = job
 		job.run(ctx, action)
+		jm.mu.Unlock()
+	}
+}
+
+func (jm *JobManager) deleteRecord(tenantID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+	defer cancel()
+	if err := jm.store.Delete(ctx, tenantID); err != nil {
+		storeFailures.WithLabelValues(storeDelete).Inc()
+		slog.Error("Failed to delete persisted job", logging.KeyTenantID, tenantID, logging.Err(err))
 	}
 }
 
-func newJob(project *nexus.RuntimeprojectRuntimeProject, jCfg config.Job,
-	endpoints config.Endpoints, am amproto.ManagementClient, sreCl sreproto.ManagementClient) *job {
-	return &job{
-		project:      project,
-		jobCfg:       jCfg,
-		endpointsCfg: endpoints,
-		amClient:     am,
-		sreClient:    sreCl,
+func newJob(project *nexus.RuntimeprojectRuntimeProject, jCfg config.Job, backends []backend.TenantBackend, st store.Store, archiver Archiver) *job {
+	j := &job{
+		jobCfg:   jCfg,
+		backends: backends,
+		store:    st,
+		archiver: archiver,
 	}
+	j.setProject(project)
+	return j
+}
+
+// setProject replaces the project of a job that is not running.
+func (j *job) setProject(project *nexus.RuntimeprojectRuntimeProject) {
+	tenantID, projectName, orgName := extractLabelsFrom(project)
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	j.project = project
+	j.orphan = false
+	j.tenantID = tenantID
+	j.projectName = projectName
+	j.orgName = orgName
+}
+
+// restore sets the job state out of a persisted record.
+func (j *job) restore(action controller.Action, r store.Record) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.action = action
+	j.attempts = r.Attempts
+	j.completedSteps = slices.Clone(r.CompletedSteps)
+	j.startedAt = r.StartedAt
+	j.deleteAt = r.DeleteAt
+	j.archive = r.Archive
+	j.archiveTo = r.ArchiveTo
+	j.archiveWindows = r.ArchiveWindows
+	j.orphan = r.Orphan
 }
 
 func (j *job) run(parentCtx context.Context, action controller.Action) {
-	j.status.Store(int32(jobInProgress))
+	prevStatus := jobStatus(j.status.Swap(int32(jobInProgress)))
+
+	j.mu.Lock()
+	if j.action != action {
+		// Progress of a different action is meaningless for the new one.
+		j.action = action
+		j.attempts = 0
+		j.completedSteps = nil
+		j.startedAt = time.Time{}
+		j.deleteAt = time.Time{}
+		j.archive = ""
+		j.archiveTo = time.Time{}
+		j.archiveWindows = 0
+	} else if prevStatus == jobFailed {
+		// Restarted failed job gets a new retry budget, completed steps are kept.
+		j.attempts = 0
+		j.startedAt = time.Time{}
+	}
+	if j.startedAt.IsZero() {
+		j.startedAt = time.Now()
+	}
+	if action == controller.CleanupTenant && j.deleteAt.IsZero() {
+		j.deleteAt = j.startedAt.Add(j.jobCfg.Cleanup.GracePeriod)
+	}
+	j.lastErr = nil
+	j.mu.Unlock()
+	j.persist()
+	j.resetStepStates(action)
+	removeJobFailure(string(j.project.UID))
+
+	ctx, cancel := context.WithCancel(logging.WithLogger(parentCtx, j.logger(action)))
+	stopped := make(chan struct{})
+	j.cancelFn = cancel
+	j.stopped = stopped
 
 	go func() {
-		ctx, cancel := context.WithCancel(parentCtx)
-		j.cancelFn = cancel
+		defer close(stopped)
 		defer cancel()
 
 		switch action {
 		case controller.InitializeTenant:
+			start := time.Now()
+			ctx, span := j.startJobSpan(ctx, action)
+			defer func() {
+				status := jobStatus(j.status.Load())
+				observeJob(action, status, start)
+				endJobSpan(span, status)
+			}()
 			j.manageTenant(ctx, j.initializeTenant, controller.InitializeTenant)
 			if errors.Is(ctx.Err(), context.Canceled) {
 				j.status.Store(int32(jobCancelled))
 				return
 			}
+			if jobStatus(j.status.Load()) == jobFailed {
+				return
+			}
 			j.status.Store(int32(tenantCreated))
+			j.finish()
 		case controller.CleanupTenant:
+			if err := j.awaitGracePeriod(ctx); err != nil {
+				j.status.Store(int32(jobCancelled))
+				return
+			}
+			start := time.Now()
+			ctx, span := j.startJobSpan(ctx, action)
+			defer func() {
+				status := jobStatus(j.status.Load())
+				observeJob(action, status, start)
+				endJobSpan(span, status)
+			}()
 			j.manageTenant(ctx, j.cleanupTenant, controller.CleanupTenant)
 			if errors.Is(ctx.Err(), context.Canceled) {
 				j.status.Store(int32(jobCancelled))
 				return
 			}
+			if jobStatus(j.status.Load()) == jobFailed {
+				return
+			}
 			if jobStatus(j.status.Load()) != tenantIDsNotMatch {
 				j.status.Store(int32(tenantDeleted))
 			}
+			j.finish()
 		}
 	}()
 }
 
+// config returns the current configuration of the job.
+func (j *job) config() config.Job {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.jobCfg
+}
+
+// needsRestart reports whether the job has to be restarted to run the action. Failed and cancelled jobs are restarted,
+// so project events give them another try.
+func (j *job) needsRestart(action controller.Action) bool {
+	j.mu.Lock()
+	current := j.action
+	j.mu.Unlock()
+
+	status := jobStatus(j.status.Load())
+	return current != action || status == jobFailed || status == jobCancelled
+}
+
+// cancel stops the running job and waits until it returns.
 func (j *job) cancel() {
 	if j.cancelFn != nil {
 		j.cancelFn()
+		<-j.stopped
+	}
+}
+
+// persist saves the current job state in the store. Failures are logged and counted in tenant_job_store_failures_total,
+// the job continues regardless.
+func (j *job) persist() {
+	j.persistMu.Lock()
+	defer j.persistMu.Unlock()
+
+	j.mu.Lock()
+	record := store.Record{
+		TenantID:       string(j.project.UID),
+		Name:           j.project.Name,
+		Action:         j.action.String(),
+		Attempts:       j.attempts,
+		CompletedSteps: slices.Clone(j.completedSteps),
+		StartedAt:      j.startedAt,
+		DeleteAt:       j.deleteAt,
+		Archive:        j.archive,
+		ArchiveTo:      j.archiveTo,
+		ArchiveWindows: j.archiveWindows,
+		Orphan:         j.orphan,
+		UpdatedAt:      time.Now(),
+	}
+	j.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+	defer cancel()
+	if err := j.store.Save(ctx, record); err != nil {
+		storeFailures.WithLabelValues(storeSave).Inc()
+		slog.Error("Failed to persist job", logging.KeyTenantID, record.TenantID, logging.KeyAction, record.Action, logging.Err(err))
+	}
+}
+
+// finish clears progress of the completed action and removes the job from the store.
+func (j *job) finish() {
+	j.mu.Lock()
+	j.attempts = 0
+	j.completedSteps = nil
+	j.startedAt = time.Time{}
+	j.deleteAt = time.Time{}
+	j.archiveTo = time.Time{}
+	j.archiveWindows = 0
+	j.mu.Unlock()
+
+	j.persistMu.Lock()
+	defer j.persistMu.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+	defer cancel()
+	if err := j.store.Delete(ctx, string(j.project.UID)); err != nil {
+		storeFailures.WithLabelValues(storeDelete).Inc()
+		slog.Error("Failed to delete persisted job", logging.KeyTenantID, j.project.UID, logging.Err(err))
 	}
 }
 
+// failAttempt records failed attempt of the job.
+func (j *job) failAttempt(err error) {
+	j.mu.Lock()
+	j.attempts++
+	j.lastErr = err
+	j.mu.Unlock()
+	j.persist()
+}
+
+// retriesExhausted reports whether the job exceeded the configured retry limits.
+func (j *job) retriesExhausted() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	retry := j.jobCfg.Retry
+	if retry.MaxAttempts > 0 && j.attempts >= retry.MaxAttempts {
+		return true
+	}
+
+	// Grace period of a cleanup does not count towards the retry duration.
+	start := j.startedAt
+	if j.deleteAt.After(start) {
+		start = j.deleteAt
+	}
+	return retry.MaxDuration > 0 && time.Since(start) >= retry.MaxDuration
+}
+
+// awaitGracePeriod keeps the tenant pending deletion until the end of the grace period. Cancelling the job
+// within the grace period, e.g. because the project was undeleted, leaves tenant telemetry intact.
+func (j *job) awaitGracePeriod(parentCtx context.Context) error {
+	j.mu.Lock()
+	deleteAt := j.deleteAt
+	j.mu.Unlock()
+
+	wait := time.Until(deleteAt)
+	if wait <= 0 {
+		return nil
+	}
+
+	id := j.project.UID
+	logger := logging.FromContext(parentCtx)
+	logger.InfoContext(parentCtx, "Tenant pending deletion", "deleteAt", deleteAt.Format(time.RFC3339))
+	ctx := context.WithValue(parentCtx, utility.ContextKeyTenantID, string(id))
+	if !j.isOrphan() {
+		err := watcher.CreateUpdateWatcher(ctx, j.project, projectwatchv1.StatusIndicationInProgress,
+			fmt.Sprintf("Tenant %q pending deletion until %v", id, deleteAt.Format(time.RFC3339)))
+		if err != nil {
+			logger.WarnContext(ctx, "Failed to report pending deletion on watcher", logging.Err(err))
+		}
+	}
+	j.status.Store(int32(tenantPendingDeletion))
+
+	if err := utility.SleepWithContext(ctx, wait); err != nil {
+		logger.InfoContext(ctx, "Pending deletion cancelled")
+		return err
+	}
+	j.status.Store(int32(jobInProgress))
+	return nil
+}
+
+// fail marks the job as failed and reports the failure on the watcher, so it is visible to the operator.
+// The job is not retried until it is restarted by a project event or on request.
+func (j *job) fail(ctx context.Context, action controller.Action, err error) {
+	j.mu.Lock()
+	attempts := j.attempts
+	orphan := j.orphan
+	j.mu.Unlock()
+
+	logger := logging.FromContext(ctx)
+	logger.ErrorContext(ctx, "Action failed - giving up", "attempts", attempts, logging.Err(err))
+	j.status.Store(int32(jobFailed))
+	jobFailures.With(prometheus.Labels{"projectId": string(j.project.UID), "action": action.String()}).Set(1)
+
+	if orphan {
+		return
+	}
+	msg := fmt.Sprintf("%v action for tenant %q failed after %d attempts: %v", action.String(), j.project.UID, attempts, err)
+	if err := watcher.CreateUpdateWatcher(ctx, j.project, projectwatchv1.StatusIndicationError, msg); err != nil {
+		logger.WarnContext(ctx, "Failed to report failure on watcher", logging.Err(err))
+	}
+}
+
+func (j *job) isOrphan() bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.orphan
+}
+
+func removeJobFailure(projectID string) {
+	jobFailures.DeletePartialMatch(prometheus.Labels{"projectId": projectID})
+}
+
+// completeStep records backend step that succeeded within the current action.
+func (j *job) completeStep(action controller.Action, name string) {
+	j.setStepState(action, name, stepCompleted)
+
+	j.mu.Lock()
+	if slices.Contains(j.completedSteps, name) {
+		j.mu.Unlock()
+		return
+	}
+	j.completedSteps = append(j.completedSteps, name)
+	j.mu.Unlock()
+	j.persist()
+}
+
+func (j *job) stepCompleted(name string) bool {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return slices.Contains(j.completedSteps, name)
+}
+
+// runSteps executes the step of every backend that has not completed yet within the current action. Backends run one
+// by one in the configured order, a failed step stops the following ones until the job is retried.
+func (j *job) runSteps(ctx context.Context, action controller.Action, step func(backend.TenantBackend, context.Context) error) error {
+	for _, b := range j.backends {
+		if j.stepCompleted(b.Name()) {
+			logging.FromContext(ctx).DebugContext(ctx, "Step already completed - skipping", logging.KeyBackend, b.Name())
+			continue
+		}
+
+		j.setStepState(action, b.Name(), stepRunning)
+		start := time.Now()
+		stepCtx, span := startStep(ctx, b.Name())
+		logger := logging.FromContext(stepCtx)
+		err := step(b, stepCtx)
+		endSpan(span, err)
+		observeStep(action, b.Name(), start, err)
+		if err != nil {
+			logger.WarnContext(stepCtx, "Step failed", logging.Err(err))
+			j.setStepState(action, b.Name(), stepFailed)
+			return err
+		}
+		logger.InfoContext(stepCtx, "Step completed")
+		j.completeStep(action, b.Name())
+	}
+	return nil
+}
+
+func (j *job) setStepState(action controller.Action, name string, state stepState) {
+	j.mu.Lock()
+	if j.stepStates == nil {
+		j.stepStates = make(map[string]stepState, len(j.backends))
+	}
+	j.stepStates[name] = state
+	j.mu.Unlock()
+
+	projectID := string(j.project.UID)
+	jobSteps.DeletePartialMatch(prometheus.Labels{"projectId": projectID, "backend": name})
+	jobSteps.With(prometheus.Labels{
+		"projectId": projectID,
+		"action":    action.String(),
+		"backend":   name,
+		"state":     string(state),
+	}).Set(1)
+}
+
+// archiveTenant exports tenant data before it is purged. The archive is created once per cleanup - retries skip it,
+// or resume it after the last checkpointed window when it failed. Archiving is bounded by its own timeout.
+func (j *job) archiveTenant(ctx context.Context) error {
+	if j.archiver == nil {
+		return nil
+	}
+	if j.stepCompleted(archiveStep) {
+		logging.FromContext(ctx).DebugContext(ctx, "Step already completed - skipping", logging.KeyBackend, archiveStep)
+		return nil
+	}
+
+	j.setStepState(controller.CleanupTenant, archiveStep, stepRunning)
+	start := time.Now()
+	archiveCtx, span := startStep(ctx, archiveStep)
+	logger := logging.FromContext(archiveCtx)
+	timedOutCtx, cancel := context.WithTimeout(archiveCtx, j.config().Archive.Timeout)
+	defer cancel()
+
+	j.mu.Lock()
+	progress := archive.Progress{To: j.archiveTo, Windows: j.archiveWindows}
+	j.mu.Unlock()
+	location, err := j.archiver.Archive(timedOutCtx, string(j.project.UID), progress, func(p archive.Progress) {
+		j.mu.Lock()
+		j.archiveTo, j.archiveWindows = p.To, p.Windows
+		j.mu.Unlock()
+		j.persist()
+	})
+	endSpan(span, err)
+	observeStep(controller.CleanupTenant, archiveStep, start, err)
+	if err != nil {
+		logger.WarnContext(archiveCtx, "Step failed", logging.Err(err))
+		j.setStepState(controller.CleanupTenant, archiveStep, stepFailed)
+		return err
+	}
+
+	logger.InfoContext(archiveCtx, "Step completed", "archive", location)
+	j.mu.Lock()
+	j.archive = location
+	j.mu.Unlock()
+	j.completeStep(controller.CleanupTenant, archiveStep)
+	return nil
+}
+
+// stepNames returns names of all steps of the action in order of execution.
+func (j *job) stepNames(action controller.Action) []string {
+	names := make([]string, 0, len(j.backends)+1)
+	if action == controller.CleanupTenant && j.archiver != nil {
+		names = append(names, archiveStep)
+	}
+	for _, b := range j.backends {
+		names = append(names, b.Name())
+	}
+	return names
+}
+
+// resetStepStates exposes initial state of all steps of the action.
+func (j *job) resetStepStates(action controller.Action) {
+	removeStepStates(string(j.project.UID))
+	for _, name := range j.stepNames(action) {
+		state := stepPending
+		if j.stepCompleted(name) {
+			state = stepCompleted
+		}
+		j.setStepState(action, name, state)
+	}
+}
+
+func removeStepStates(projectID string) {
+	jobSteps.DeletePartialMatch(prometheus.Labels{"projectId": projectID})
+}
+
 func (j *job) manageTenant(parentCtx context.Context, tenantAction func(context.Context) error, action controller.Action) {
 	cnt := 0
 	id := j.project.UID
 	ctx := context.WithValue(parentCtx, utility.ContextKeyTenantID, string(id))
 
+	logger := logging.FromContext(ctx)
+
 	for {
-		err := tenantAction(ctx)
+		attemptCtx, span := j.startAttempt(ctx)
+		err := tenantAction(attemptCtx)
+		endSpan(span, err)
 		if err == nil {
-			log.Printf("%v action for tenantID %q completed successfully", action.String(), id)
+			logger.InfoContext(ctx, "Action completed successfully")
 			break
 		}
 
 		if errors.As(err, &watcher.IDsDoNotMatchError{}) {
-			log.Printf("%v action for tenantID %q completed successfully - watcher deleted manually", action.String(), id)
+			logger.InfoContext(ctx, "Action completed successfully - watcher deleted manually")
 			j.status.Store(int32(tenantIDsNotMatch))
 			break
 		}
 
-		log.Printf("Failed to %s: %v", action.String(), err)
+		if ctx.Err() != nil {
+			logger.InfoContext(ctx, "Action cancelled")
+			break
+		}
 
-		sleepTime := j.jobCfg.Backoff.Max
+		logging.FromContext(attemptCtx).WarnContext(attemptCtx, "Action attempt failed", logging.Err(err))
+		j.failAttempt(err)
+		if j.retriesExhausted() {
+			j.fail(ctx, action, err)
+			break
+		}
+
+		// Backoff is read on every retry, so reloaded configuration applies to running jobs.
+		backoff := j.config().Backoff
+		sleepTime := backoff.Max
 
-		calcTime := math.Pow(j.jobCfg.Backoff.TimeMultiplier, float64(cnt)) * float64(j.jobCfg.Backoff.Initial)
-		if calcTime < float64(j.jobCfg.Backoff.Max) {
+		calcTime := math.Pow(backoff.TimeMultiplier, float64(cnt)) * float64(backoff.Initial)
+		if calcTime < float64(backoff.Max) {
 			sleepTime = time.Duration(calcTime)
 			cnt++
 		}
 
 		err = utility.SleepWithContext(ctx, sleepTime)
 		if errors.Is(err, context.Canceled) {
-			log.Printf("%v action for tenantID %q cancelled", action.String(), id)
+			logger.InfoContext(ctx, "Action cancelled")
 			break
 		}
 	}
 }
 
 func (j *job) initializeTenant(parentCtx context.Context) error {
-	timedOutCtx, cancel := context.WithTimeout(parentCtx, j.jobCfg.Timeout)
+	timedOutCtx, cancel := context.WithTimeout(parentCtx, j.config().Timeout)
 	defer cancel()
 	err := watcher.CreateUpdateWatcher(parentCtx, j.project,
 		projectwatchv1.StatusIndicationInProgress, fmt.Sprintf("Creating tenant %q", j.project.UID))
@@ -231,14 +841,7 @@ func (j *job) initializeTenant(parentCtx context.Context) error {
 		return err
 	}
 
-	g, ctx := errgroup.WithContext(timedOutCtx)
-
-	g.Go(func() error { return alertingmonitor.InitializeTenant(ctx, j.amClient) })
-	if j.jobCfg.Sre.Enabled {
-		g.Go(func() error { return sre.InitializeTenant(ctx, j.sreClient) })
-	}
-
-	if err := g.Wait(); err != nil {
+	if err := j.runSteps(timedOutCtx, controller.InitializeTenant, backend.TenantBackend.Initialize); err != nil {
 		return err
 	}
 
@@ -247,24 +850,29 @@ func (j *job) initializeTenant(parentCtx context.Context) error {
 }
 
 func (j *job) cleanupTenant(parentCtx context.Context) error {
-	timedOutCtx, cancel := context.WithTimeout(parentCtx, j.jobCfg.Timeout)
-	defer cancel()
+	if j.isOrphan() {
+		if err := j.archiveTenant(parentCtx); err != nil {
+			return err
+		}
+		timedOutCtx, cancel := context.WithTimeout(parentCtx, j.config().Timeout)
+		defer cancel()
+		return j.runSteps(timedOutCtx, controller.CleanupTenant, backend.TenantBackend.Cleanup)
+	}
+
 	err := watcher.CreateUpdateWatcher(parentCtx, j.project,
 		projectwatchv1.StatusIndicationInProgress, fmt.Sprintf("Deleting tenant %q", j.project.UID))
 	if err != nil {
 		return err
 	}
 
-	g, ctx := errgroup.WithContext(timedOutCtx)
-
-	g.Go(func() error { return alertingmonitor.CleanupTenant(ctx, j.amClient) })
-	if j.jobCfg.Sre.Enabled {
-		g.Go(func() error { return sre.CleanupTenant(ctx, j.sreClient) })
+	if err := j.archiveTenant(parentCtx); err != nil {
+		return err
 	}
-	g.Go(func() error { return loki.CleanupTenant(ctx, j.endpointsCfg.Loki) })
-	g.Go(func() error { return mimir.CleanupTenant(ctx, j.endpointsCfg.Mimir) })
 
-	if err := g.Wait(); err != nil {
+	// Job timeout starts after archiving, which has its own one.
+	timedOutCtx, cancel := context.WithTimeout(parentCtx, j.config().Timeout)
+	defer cancel()
+	if err := j.runSteps(timedOutCtx, controller.CleanupTenant, backend.TenantBackend.Cleanup); err != nil {
 		return err
 	}
 
@@ -286,7 +894,7 @@ func setProjectMetadata(project *nexus.RuntimeprojectRuntimeProject, action cont
 	}
 
 	projectIDs.With(labels).Set(1)
-	log.Printf("Added project metadata: %q", labels)
+	slog.Debug("Added project metadata", "labels", labels)
 }
 
 func removeProjectMetadata(project *nexus.RuntimeprojectRuntimeProject) {
@@ -300,9 +908,9 @@ func removeProjectMetadata(project *nexus.RuntimeprojectRuntimeProject) {
 
 	numberDeleted := projectIDs.DeletePartialMatch(labels)
 	if numberDeleted > 0 {
-		log.Printf("Removed project metadata: %q", labels)
+		slog.Debug("Removed project metadata", "labels", labels)
 	} else {
-		log.Printf("Failed to remove project metadata: %q", labels)
+		slog.Debug("Failed to remove project metadata", "labels", labels)
 	}
 }
 
@@ -313,12 +921,19 @@ func removeProjectMetadataByID(projectID string) {
 
 	numberDeleted := projectIDs.DeletePartialMatch(labels)
 	if numberDeleted > 0 {
-		log.Printf("Removed project metadata: %q", labels)
+		slog.Debug("Removed project metadata", "labels", labels)
 	} else {
-		log.Printf("Failed to remove project metadata: %q", labels)
+		slog.Debug("Failed to remove project metadata", "labels", labels)
 	}
 }
 
+// logger returns the logger carrying fields that identify the tenant and the action of the job.
+func (j *job) logger(action controller.Action) *slog.Logger {
+	projectID, projectName, orgName := extractLabelsFrom(j.project)
+	return slog.Default().With(logging.KeyTenantID, projectID, logging.KeyProject, projectName, logging.KeyOrg, orgName,
+		logging.KeyAction, action.String())
+}
+
 func extractLabelsFrom(project *nexus.RuntimeprojectRuntimeProject) (projectID, projectName, orgName string) {
 	orgName = ""
 	if project.GetLabels() != nil {
@@ -0,0 +1,496 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	projectwatchv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectactivewatcher.edge-orchestrator.intel.com/v1"
+	runtimev1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtime.edge-orchestrator.intel.com/v1"
+	folderv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtimefolder.edge-orchestrator.intel.com/v1"
+	orgv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtimeorg.edge-orchestrator.intel.com/v1"
+	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtimeproject.edge-orchestrator.intel.com/v1"
+	nexus "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/archive"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/backend"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/controller"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/store"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/util"
+)
+
+type fakeBackend struct {
+	name        string
+	err         error
+	initialized atomic.Int32
+	cleanedUp   atomic.Int32
+}
+
+func (b *fakeBackend) Name() string {
+	return b.name
+}
+
+func (b *fakeBackend) Initialize(_ context.Context) error {
+	b.initialized.Add(1)
+	return b.err
+}
+
+func (b *fakeBackend) Cleanup(_ context.Context) error {
+	b.cleanedUp.Add(1)
+	return b.err
+}
+
+func (*fakeBackend) Verify(_ context.Context) error {
+	return nil
+}
+
+func TestInitializeTenant(t *testing.T) {
+	t.Run("All backends initialized - no error expected", func(t *testing.T) {
+		first, second := &fakeBackend{name: "first"}, &fakeBackend{name: "second"}
+		j := prepareJob(t, first, second)
+
+		require.NoError(t, j.initializeTenant(tenantContext(t, j)), "Function returned an error")
+		require.Equal(t, int32(1), first.initialized.Load(), "Backend not initialized")
+		require.Equal(t, int32(1), second.initialized.Load(), "Backend not initialized")
+	})
+
+	t.Run("Backend failure - error expected", func(t *testing.T) {
+		failing := &fakeBackend{name: "failing", err: errors.New("backend failure")}
+		j := prepareJob(t, &fakeBackend{name: "working"}, failing)
+
+		require.ErrorContains(t, j.initializeTenant(tenantContext(t, j)), "backend failure")
+	})
+}
+
+func TestCleanupTenant(t *testing.T) {
+	t.Run("All backends cleaned up - no error expected", func(t *testing.T) {
+		first, second := &fakeBackend{name: "first"}, &fakeBackend{name: "second"}
+		j := prepareJob(t, first, second)
+
+		require.NoError(t, j.cleanupTenant(tenantContext(t, j)), "Function returned an error")
+		require.Equal(t, int32(1), first.cleanedUp.Load(), "Backend not cleaned up")
+		require.Equal(t, int32(1), second.cleanedUp.Load(), "Backend not cleaned up")
+		require.Zero(t, first.initialized.Load(), "Backend initialized during cleanup")
+	})
+
+	t.Run("Backend failure - error expected", func(t *testing.T) {
+		failing := &fakeBackend{name: "failing", err: errors.New("backend failure")}
+		j := prepareJob(t, failing)
+
+		require.ErrorContains(t, j.cleanupTenant(tenantContext(t, j)), "backend failure")
+	})
+
+	t.Run("Backends run in order - failure stops the following ones", func(t *testing.T) {
+		failing := &fakeBackend{name: "failing", err: errors.New("backend failure")}
+		following := &fakeBackend{name: "following"}
+		j := prepareJob(t, failing, following)
+
+		require.Error(t, j.cleanupTenant(tenantContext(t, j)), "Function doesn't return an error")
+		require.Zero(t, following.cleanedUp.Load(), "Backend cleaned up before the preceding one")
+	})
+}
+
+func TestStepCheckpointing(t *testing.T) {
+	working := &fakeBackend{name: "working"}
+	failing := &fakeBackend{name: "failing", err: errors.New("backend failure")}
+	j := prepareJob(t, working, failing)
+	ctx := tenantContext(t, j)
+
+	require.Error(t, j.cleanupTenant(ctx), "Function doesn't return an error")
+	require.Error(t, j.cleanupTenant(ctx), "Function doesn't return an error")
+	require.Equal(t, int32(1), working.cleanedUp.Load(), "Completed step executed again")
+	require.Equal(t, int32(2), failing.cleanedUp.Load(), "Failed step not retried")
+
+	stepState := func(name string, state stepState) float64 {
+		return testutil.ToFloat64(jobSteps.With(prometheus.Labels{
+			"projectId": string(j.project.UID),
+			"action":    controller.CleanupTenant.String(),
+			"backend":   name,
+			"state":     string(state),
+		}))
+	}
+	require.InEpsilon(t, 1.0, stepState("working", stepCompleted), 0, "Step state different from expected")
+	require.InEpsilon(t, 1.0, stepState("failing", stepFailed), 0, "Step state different from expected")
+
+	failing.err = nil
+	require.NoError(t, j.cleanupTenant(ctx), "Function returned an error")
+	require.Equal(t, int32(1), working.cleanedUp.Load(), "Completed step executed again")
+	require.Equal(t, int32(3), failing.cleanedUp.Load(), "Failed step not retried")
+
+	j.finish()
+	require.NoError(t, j.cleanupTenant(ctx), "Function returned an error")
+	require.Equal(t, int32(2), working.cleanedUp.Load(), "Steps not executed after action finished")
+}
+
+func TestResume(t *testing.T) {
+	t.Run("Persisted job resumed and removed from store after completion", func(t *testing.T) {
+		project := prepareProject(t)
+		st := store.NewMemoryStore()
+		require.NoError(t, st.Save(t.Context(), store.Record{
+			TenantID: string(project.UID),
+			Name:     project.Name,
+			Action:   controller.CleanupTenant.String(),
+			Attempts: 2,
+		}))
+
+		b := &fakeBackend{name: "first"}
+		jm := prepareJobManager(t, st, func(_ context.Context, _ string) (*nexus.RuntimeprojectRuntimeProject, error) {
+			return project, nil
+		}, b)
+
+		require.Eventually(t, func() bool { return b.cleanedUp.Load() == 1 }, time.Second, 10*time.Millisecond, "Job not resumed")
+		require.Eventually(t, func() bool {
+			records, err := st.List(t.Context())
+			return err == nil && len(records) == 0
+		}, time.Second, 10*time.Millisecond, "Job not removed from store")
+		jm.Stop()
+	})
+
+	t.Run("Persisted job of removed project dropped", func(t *testing.T) {
+		st := store.NewMemoryStore()
+		require.NoError(t, st.Save(t.Context(), store.Record{TenantID: "foo", Name: "foo", Action: controller.CleanupTenant.String()}))
+
+		b := &fakeBackend{name: "first"}
+		jm := prepareJobManager(t, st, func(_ context.Context, name string) (*nexus.RuntimeprojectRuntimeProject, error) {
+			return nil, k8serrors.NewNotFound(schema.GroupResource{}, name)
+		}, b)
+
+		require.Eventually(t, func() bool {
+			records, err := st.List(t.Context())
+			return err == nil && len(records) == 0
+		}, time.Second, 10*time.Millisecond, "Job not removed from store")
+		require.Zero(t, b.cleanedUp.Load(), "Job of removed project executed")
+		jm.Stop()
+	})
+}
+
+func TestPersistence(t *testing.T) {
+	b := &fakeBackend{name: "failing", err: errors.New("backend failure")}
+	j := prepareJob(t, &fakeBackend{name: "working"}, b)
+	j.jobCfg.Backoff.Initial = time.Hour
+	j.jobCfg.Backoff.Max = time.Hour
+	j.jobCfg.Backoff.TimeMultiplier = 1
+
+	j.run(t.Context(), controller.CleanupTenant)
+	require.Eventually(t, func() bool {
+		records, err := j.store.List(t.Context())
+		return err == nil && len(records) == 1 && records[0].Attempts == 1
+	}, time.Second, 10*time.Millisecond, "Failed attempt not persisted")
+	j.cancel()
+
+	records, err := j.store.List(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, controller.CleanupTenant.String(), records[0].Action)
+	require.Equal(t, []string{"working"}, records[0].CompletedSteps)
+	require.Equal(t, jobCancelled, jobStatus(j.status.Load()))
+}
+
+func TestUpdateConfig(t *testing.T) {
+	project := prepareProject(t)
+	b := &fakeBackend{name: "failing", err: errors.New("backend failure")}
+	jm := prepareJobManager(t, store.NewMemoryStore(), nil, b)
+	defer jm.Stop()
+	jm.jobCfg.Backoff.Initial = time.Hour
+	jm.jobCfg.Backoff.Max = time.Hour
+	jm.jobCfg.Backoff.TimeMultiplier = 1
+
+	id := string(project.UID)
+	jm.comSig <- controller.CommChannel{Project: project, Status: controller.CleanupTenant}
+	require.Eventually(t, func() bool {
+		info, err := jm.Job(id)
+		return err == nil && info.Attempts == 1
+	}, time.Second, 10*time.Millisecond, "Failed attempt not reported")
+
+	var jCfg config.Job
+	jCfg.Manager.Deletion.Rate = time.Minute
+	jCfg.Backoff.Initial = time.Second
+	jCfg.Retry.MaxAttempts = 3
+	jCfg.Store.Type = "file"
+	jm.UpdateConfig(jCfg)
+
+	jm.mu.RLock()
+	j := jm.jobList[types.UID(id)]
+	jm.mu.RUnlock()
+	require.Equal(t, time.Second, j.config().Backoff.Initial, "Running job not reconfigured")
+	require.Equal(t, 3, j.config().Retry.MaxAttempts, "Running job not reconfigured")
+	require.Empty(t, j.config().Store.Type, "Store config changed without restart")
+	require.Equal(t, jobInProgress, jobStatus(j.status.Load()), "Running job interrupted")
+}
+
+func prepareJobManager(t *testing.T, st store.Store, resolve ProjectResolver, backends ...*fakeBackend) *JobManager {
+	t.Helper()
+
+	tenantBackends := make([]backend.TenantBackend, 0, len(backends))
+	for _, b := range backends {
+		tenantBackends = append(tenantBackends, b)
+	}
+
+	var jCfg config.Job
+	jCfg.Timeout = time.Minute
+	jm := New(make(chan controller.CommChannel), jCfg, tenantBackends, st, nil, resolve)
+	ticker := time.NewTicker(time.Hour)
+	t.Cleanup(ticker.Stop)
+	jm.Start(ticker)
+	return jm
+}
+
+func prepareJob(t *testing.T, backends ...*fakeBackend) *job {
+	t.Helper()
+
+	project := prepareProject(t)
+	tenantBackends := make([]backend.TenantBackend, 0, len(backends))
+	for _, b := range backends {
+		tenantBackends = append(tenantBackends, b)
+	}
+
+	var jCfg config.Job
+	jCfg.Timeout = time.Minute
+	jCfg.Archive.Timeout = time.Minute
+	return newJob(project, jCfg, tenantBackends, store.NewMemoryStore(), nil)
+}
+
+func tenantContext(t *testing.T, j *job) context.Context {
+	t.Helper()
+	return context.WithValue(t.Context(), utility.ContextKeyTenantID, string(j.project.UID))
+}
+
+func prepareProject(t *testing.T) *nexus.RuntimeprojectRuntimeProject {
+	t.Helper()
+
+	client := nexus.NewFakeClient()
+	runtime, err := client.TenancyMultiTenancy().AddRuntime(t.Context(), &runtimev1.Runtime{})
+	require.NoError(t, err, "Error creating runtime")
+	org, err := runtime.AddOrgs(t.Context(), &orgv1.RuntimeOrg{})
+	require.NoError(t, err, "Error creating org")
+	folder, err := org.AddFolders(t.Context(), &folderv1.RuntimeFolder{})
+	require.NoError(t, err, "Error creating folder")
+	project, err := folder.AddProjects(t.Context(), &projectv1.RuntimeProject{})
+	require.NoError(t, err, "Error creating project")
+	return project
+}
+
+func TestJobAdmin(t *testing.T) {
+	project := prepareProject(t)
+	id := string(project.UID)
+	b := &fakeBackend{name: "failing", err: errors.New("backend failure")}
+	st := store.NewMemoryStore()
+	jm := prepareJobManager(t, st, func(_ context.Context, name string) (*nexus.RuntimeprojectRuntimeProject, error) {
+		return nil, k8serrors.NewNotFound(schema.GroupResource{}, name)
+	}, b)
+	defer jm.Stop()
+	jm.jobCfg.Backoff.Initial = time.Hour
+	jm.jobCfg.Backoff.Max = time.Hour
+	jm.jobCfg.Backoff.TimeMultiplier = 1
+
+	_, err := jm.Job("foo")
+	require.ErrorIs(t, err, ErrJobNotFound)
+
+	jm.comSig <- controller.CommChannel{Project: project, Status: controller.CleanupTenant}
+	require.Eventually(t, func() bool {
+		info, err := jm.Job(id)
+		return err == nil && info.Attempts == 1
+	}, time.Second, 10*time.Millisecond, "Failed attempt not reported")
+
+	info, err := jm.Job(id)
+	require.NoError(t, err)
+	require.Equal(t, controller.CleanupTenant.String(), info.Action)
+	require.Equal(t, jobInProgress.String(), info.Status)
+	require.Equal(t, "backend failure", info.LastError)
+	require.Equal(t, []StepInfo{{Backend: "failing", State: string(stepFailed)}}, info.Steps)
+	require.Len(t, jm.Jobs(), 1)
+
+	_, err = jm.RetryJob(id)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return b.cleanedUp.Load() == 2 }, time.Second, 10*time.Millisecond, "Job not retried")
+
+	info, err = jm.CancelJob(id)
+	require.NoError(t, err)
+	require.Equal(t, jobCancelled.String(), info.Status)
+	records, err := st.List(t.Context())
+	require.NoError(t, err)
+	require.Empty(t, records, "Cancelled job not removed from store")
+
+	_, err = jm.CancelJob(id)
+	require.ErrorIs(t, err, ErrJobNotRunning)
+
+	b.err = nil
+	_, err = jm.RetryJob(id)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		info, err := jm.Job(id)
+		return err == nil && info.Status == tenantDeleted.String()
+	}, time.Second, 10*time.Millisecond, "Retried job not completed")
+
+	_, err = jm.RetryJob(id)
+	require.ErrorIs(t, err, ErrJobFinished)
+}
+
+func TestRetryLimits(t *testing.T) {
+	tests := []struct {
+		name        string
+		maxAttempts int
+		maxDuration time.Duration
+		attempts    int
+	}{
+		{name: "Max attempts exceeded", maxAttempts: 3, attempts: 3},
+		{name: "Max duration exceeded", maxDuration: time.Nanosecond, attempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &fakeBackend{name: "failing", err: errors.New("backend failure")}
+			j := prepareJob(t, b)
+			j.jobCfg.Retry.MaxAttempts = tt.maxAttempts
+			j.jobCfg.Retry.MaxDuration = tt.maxDuration
+
+			j.run(t.Context(), controller.InitializeTenant)
+			<-j.stopped
+
+			info := j.info()
+			require.Equal(t, jobFailed.String(), info.Status, "Job status different from expected")
+			require.Equal(t, tt.attempts, info.Attempts, "Number of attempts different from expected")
+			require.Equal(t, "backend failure", info.LastError)
+			require.InEpsilon(t, 1.0, testutil.ToFloat64(jobFailures.With(prometheus.Labels{
+				"projectId": string(j.project.UID),
+				"action":    controller.InitializeTenant.String(),
+			})), 0, "Failure not exposed")
+
+			watcher, err := j.project.GetActiveWatchers(t.Context(), utility.AppName)
+			require.NoError(t, err)
+			require.Equal(t, projectwatchv1.StatusIndicationError, watcher.Spec.StatusIndicator)
+			require.Contains(t, watcher.Spec.Message, "backend failure")
+
+			records, err := j.store.List(t.Context())
+			require.NoError(t, err)
+			require.Len(t, records, 1, "Failed job removed from store")
+
+			b.err = nil
+			j.run(t.Context(), controller.InitializeTenant)
+			<-j.stopped
+			require.Equal(t, tenantCreated.String(), j.info().Status, "Restarted job not completed")
+			require.Zero(t, testutil.CollectAndCount(jobFailures, "tenant_job_failed"), "Failure still exposed")
+		})
+	}
+}
+
+func TestGracePeriod(t *testing.T) {
+	t.Run("Undelete within grace period - telemetry not purged", func(t *testing.T) {
+		b := &fakeBackend{name: "first"}
+		j := prepareJob(t, b)
+		j.jobCfg.Cleanup.GracePeriod = time.Hour
+
+		j.run(t.Context(), controller.CleanupTenant)
+		require.Eventually(t, func() bool {
+			return j.info().Status == tenantPendingDeletion.String()
+		}, time.Second, 10*time.Millisecond, "Tenant not pending deletion")
+		require.WithinDuration(t, time.Now().Add(time.Hour), j.info().DeleteAt, time.Minute)
+
+		records, err := j.store.List(t.Context())
+		require.NoError(t, err)
+		require.Len(t, records, 1)
+		require.Equal(t, j.info().DeleteAt, records[0].DeleteAt, "Grace period not persisted")
+
+		watcher, err := j.project.GetActiveWatchers(t.Context(), utility.AppName)
+		require.NoError(t, err)
+		require.Contains(t, watcher.Spec.Message, "pending deletion")
+
+		j.cancel()
+		j.run(t.Context(), controller.InitializeTenant)
+		<-j.stopped
+		require.Equal(t, tenantCreated.String(), j.info().Status, "Tenant not restored")
+		require.Zero(t, b.cleanedUp.Load(), "Tenant cleaned up within grace period")
+	})
+
+	t.Run("Grace period elapsed - telemetry purged", func(t *testing.T) {
+		b := &fakeBackend{name: "first"}
+		j := prepareJob(t, b)
+		j.jobCfg.Cleanup.GracePeriod = 50 * time.Millisecond
+
+		j.run(t.Context(), controller.CleanupTenant)
+		<-j.stopped
+		require.Equal(t, tenantDeleted.String(), j.info().Status, "Tenant not deleted")
+		require.Equal(t, int32(1), b.cleanedUp.Load(), "Tenant not cleaned up after grace period")
+	})
+}
+
+type fakeArchiver struct {
+	archived atomic.Int32
+	// windows is the number of windows exported by the archive, err fails the archive once after the first one.
+	windows int
+	err     error
+	started []archive.Progress
+}
+
+func (a *fakeArchiver) Archive(_ context.Context, tenantID string, progress archive.Progress, checkpoint func(archive.Progress)) (string, error) {
+	a.archived.Add(1)
+	a.started = append(a.started, progress)
+	if progress.To.IsZero() {
+		progress.To = time.Now()
+	}
+	for progress.Windows < a.windows {
+		progress.Windows++
+		checkpoint(progress)
+		if err := a.err; err != nil {
+			a.err = nil
+			return "", err
+		}
+	}
+	return "archive/" + tenantID, nil
+}
+
+func TestArchiveStep(t *testing.T) {
+	failing := &fakeBackend{name: "failing", err: errors.New("backend failure")}
+	j := prepareJob(t, failing)
+	archiver := &fakeArchiver{}
+	j.archiver = archiver
+	j.action = controller.CleanupTenant
+	ctx := tenantContext(t, j)
+
+	require.Error(t, j.cleanupTenant(ctx), "Function doesn't return an error")
+	require.Error(t, j.cleanupTenant(ctx), "Function doesn't return an error")
+	require.Equal(t, int32(1), archiver.archived.Load(), "Tenant archived more than once")
+
+	info := j.info()
+	require.Equal(t, "archive/"+string(j.project.UID), info.Archive, "Archive location not recorded")
+	require.Equal(t, []StepInfo{
+		{Backend: archiveStep, State: string(stepCompleted)},
+		{Backend: "failing", State: string(stepFailed)},
+	}, info.Steps)
+
+	records, err := j.store.List(t.Context())
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, info.Archive, records[0].Archive, "Archive location not persisted")
+}
+
+func TestArchiveResume(t *testing.T) {
+	j := prepareJob(t, &fakeBackend{name: "loki"})
+	archiver := &fakeArchiver{windows: 3, err: errors.New("archive failure")}
+	j.archiver = archiver
+	j.action = controller.CleanupTenant
+	ctx := tenantContext(t, j)
+
+	require.Error(t, j.cleanupTenant(ctx), "Function doesn't return an error")
+	records, err := j.store.List(t.Context())
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, 1, records[0].ArchiveWindows, "Archive checkpoint not persisted")
+	require.False(t, records[0].ArchiveTo.IsZero(), "Archive range not persisted")
+
+	require.NoError(t, j.cleanupTenant(ctx))
+	require.Len(t, archiver.started, 2)
+	require.Equal(t, records[0].ArchiveTo, archiver.started[1].To, "Archive not resumed")
+	require.Equal(t, 1, archiver.started[1].Windows, "Archive not resumed after the exported window")
+}
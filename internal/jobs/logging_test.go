@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	nexus "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/controller"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/store"
+)
+
+// syncBuffer is written by job goroutines while the test reads it.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+// records returns decoded log records with the given message.
+func (b *syncBuffer) records(t *testing.T, msg string) []map[string]any {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(b.buf.String()), "\n") {
+		var record map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &record))
+		if record["msg"] == msg {
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+func TestJobLogFields(t *testing.T) {
+	// Spans are recorded, so trace IDs are valid.
+	spanRecorder()
+	var out syncBuffer
+	logger, err := logging.New(config.Logging{}, &out)
+	require.NoError(t, err)
+	defaultLogger := slog.Default()
+	slog.SetDefault(logger)
+	defer slog.SetDefault(defaultLogger)
+
+	project := prepareProject(t)
+	id := string(project.UID)
+	b := &fakeBackend{name: "logging", err: errors.New("backend failure")}
+	jm := prepareJobManager(t, store.NewMemoryStore(), func(_ context.Context, _ string) (*nexus.RuntimeprojectRuntimeProject, error) {
+		return project, nil
+	}, b)
+	defer jm.Stop()
+	jm.jobCfg.Backoff.Initial = time.Millisecond
+	jm.jobCfg.Backoff.Max = time.Millisecond
+	jm.jobCfg.Backoff.TimeMultiplier = 1
+	jm.jobCfg.Retry.MaxAttempts = 2
+
+	jm.comSig <- controller.CommChannel{Project: project, Status: controller.InitializeTenant}
+	require.Eventually(t, func() bool {
+		info, err := jm.Job(id)
+		return err == nil && info.Status == jobFailed.String()
+	}, time.Second, 10*time.Millisecond, "Job not failed")
+
+	var steps []map[string]any
+	require.Eventually(t, func() bool {
+		steps = out.records(t, "Step failed")
+		return len(steps) == 2
+	}, time.Second, 10*time.Millisecond, "Step failures not logged")
+	for i, record := range steps {
+		require.Equal(t, id, record[logging.KeyTenantID])
+		require.Equal(t, project.DisplayName(), record[logging.KeyProject])
+		require.Equal(t, controller.InitializeTenant.String(), record[logging.KeyAction])
+		require.Equal(t, "logging", record[logging.KeyBackend])
+		require.InDelta(t, i+1, record[logging.KeyAttempt], 0)
+		require.Equal(t, "backend failure", record[logging.KeyError])
+		require.NotEmpty(t, record["traceID"], "Record not correlated with the trace")
+	}
+
+	failures := out.records(t, "Action failed - giving up")
+	require.Len(t, failures, 1)
+	require.Equal(t, id, failures[0][logging.KeyTenantID])
+	require.NotContains(t, failures[0], logging.KeyAttempt, "Job level record should not carry attempt")
+}
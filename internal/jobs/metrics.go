@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package jobs
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/controller"
+)
+
+const (
+	resultSuccess   = "success"
+	resultFailure   = "failure"
+	resultCompleted = "completed"
+	resultFailed    = "failed"
+	resultCancelled = "cancelled"
+
+	storeSave   = "save"
+	storeDelete = "delete"
+)
+
+var jobDuration = promauto.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "tenant_job_duration_seconds",
+		Help:    "Duration of tenant job runs including retries, excluding the grace period of a cleanup",
+		Buckets: prometheus.ExponentialBuckets(0.1, 2, 16),
+	}, []string{"action", "result"},
+)
+
+var stepDuration = promauto.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Name:    "tenant_job_step_duration_seconds",
+		Help:    "Duration of single attempts of tenant job steps",
+		Buckets: prometheus.ExponentialBuckets(0.05, 2, 14),
+	}, []string{"backend", "action", "result"},
+)
+
+var stepAttempts = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "tenant_job_step_attempts_total",
+		Help: "Number of attempts of tenant job steps",
+	}, []string{"backend", "action"},
+)
+
+var stepFailures = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "tenant_job_step_failures_total",
+		Help: "Number of failed attempts of tenant job steps",
+	}, []string{"backend", "action"},
+)
+
+var storeFailures = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "tenant_job_store_failures_total",
+		Help: "Number of job state changes that could not be written to the job store",
+	}, []string{"operation"},
+)
+
+var jobsDesc = prometheus.NewDesc(
+	"tenant_jobs",
+	"Number of tenant jobs known to the job manager by state",
+	[]string{"state"}, nil,
+)
+
+// observeStep records a single attempt of the step.
+func observeStep(action controller.Action, name string, start time.Time, err error) {
+	result := resultSuccess
+	stepAttempts.WithLabelValues(name, action.String()).Inc()
+	if err != nil {
+		result = resultFailure
+		stepFailures.WithLabelValues(name, action.String()).Inc()
+	}
+	stepDuration.WithLabelValues(name, action.String(), result).Observe(time.Since(start).Seconds())
+}
+
+// observeJob records the run of the job that returned with the given status.
+func observeJob(action controller.Action, status jobStatus, start time.Time) {
+	result := resultCompleted
+	switch status {
+	case jobCancelled:
+		result = resultCancelled
+	case jobFailed:
+		result = resultFailed
+	default:
+	}
+	jobDuration.WithLabelValues(action.String(), result).Observe(time.Since(start).Seconds())
+}
+
+// Describe implements prometheus.Collector, exposing the number of jobs by state.
+func (jm *JobManager) Describe(ch chan<- *prometheus.Desc) {
+	ch <- jobsDesc
+}
+
+// Collect implements prometheus.Collector. Every state is exposed, so the series do not disappear when empty.
+func (jm *JobManager) Collect(ch chan<- prometheus.Metric) {
+	counts := map[jobStatus]int{}
+	jm.mu.RLock()
+	for _, j := range jm.jobList {
+		counts[jobStatus(j.status.Load())]++
+	}
+	jm.mu.RUnlock()
+
+	for status := jobCreated; status <= tenantPendingDeletion; status++ {
+		ch <- prometheus.MustNewConstMetric(jobsDesc, prometheus.GaugeValue, float64(counts[status]), status.String())
+	}
+}
@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	nexus "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/controller"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/store"
+)
+
+func TestJobMetrics(t *testing.T) {
+	project := prepareProject(t)
+	id := string(project.UID)
+	b := &fakeBackend{name: "metrics", err: errors.New("backend failure")}
+	jm := prepareJobManager(t, store.NewMemoryStore(), func(_ context.Context, _ string) (*nexus.RuntimeprojectRuntimeProject, error) {
+		return project, nil
+	}, b)
+	defer jm.Stop()
+	jm.jobCfg.Backoff.Initial = time.Millisecond
+	jm.jobCfg.Backoff.Max = time.Millisecond
+	jm.jobCfg.Backoff.TimeMultiplier = 1
+	jm.jobCfg.Retry.MaxAttempts = 2
+
+	action := controller.InitializeTenant.String()
+	attemptsBefore := testutil.ToFloat64(stepAttempts.WithLabelValues("metrics", action))
+	failuresBefore := testutil.ToFloat64(stepFailures.WithLabelValues("metrics", action))
+	stepsBefore := sampleCount(t, stepDuration, "metrics")
+	jobsBefore := sampleCount(t, jobDuration, resultFailed)
+
+	jm.comSig <- controller.CommChannel{Project: project, Status: controller.InitializeTenant}
+	require.Eventually(t, func() bool {
+		info, err := jm.Job(id)
+		return err == nil && info.Status == jobFailed.String()
+	}, time.Second, 10*time.Millisecond, "Job not failed")
+
+	require.InDelta(t, attemptsBefore+2, testutil.ToFloat64(stepAttempts.WithLabelValues("metrics", action)), 0)
+	require.InDelta(t, failuresBefore+2, testutil.ToFloat64(stepFailures.WithLabelValues("metrics", action)), 0)
+	require.Equal(t, stepsBefore+2, sampleCount(t, stepDuration, "metrics"), "Step duration not observed")
+	require.Eventually(t, func() bool {
+		return sampleCount(t, jobDuration, resultFailed) == jobsBefore+1
+	}, time.Second, 10*time.Millisecond, "Job duration not observed")
+
+	reg := prometheus.NewPedanticRegistry()
+	reg.MustRegister(jm)
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	require.Len(t, families, 1)
+
+	states := map[string]float64{}
+	for _, m := range families[0].GetMetric() {
+		states[m.GetLabel()[0].GetValue()] = m.GetGauge().GetValue()
+	}
+	require.Len(t, states, int(tenantPendingDeletion)+1, "Not every state exposed")
+	require.InDelta(t, 1, states[jobFailed.String()], 0)
+	require.InDelta(t, 0, states[jobInProgress.String()], 0)
+}
+
+// sampleCount returns number of observations of the histogram series with the given label value.
+func sampleCount(t *testing.T, histogram prometheus.Collector, labelValue string) uint64 {
+	t.Helper()
+
+	reg := prometheus.NewPedanticRegistry()
+	reg.MustRegister(histogram)
+	families, err := reg.Gather()
+	require.NoError(t, err)
+
+	var count uint64
+	for _, f := range families {
+		for _, m := range f.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetValue() == labelValue {
+					count += m.GetHistogram().GetSampleCount()
+				}
+			}
+		}
+	}
+	return count
+}
+
+type failingStore struct {
+	store.Store
+}
+
+func (failingStore) Save(context.Context, store.Record) error {
+	return errors.New("store failure")
+}
+
+func (failingStore) Delete(context.Context, string) error {
+	return errors.New("store failure")
+}
+
+func TestStoreFailureMetrics(t *testing.T) {
+	j := prepareJob(t)
+	j.store = failingStore{Store: store.NewMemoryStore()}
+
+	savesBefore := testutil.ToFloat64(storeFailures.WithLabelValues(storeSave))
+	deletesBefore := testutil.ToFloat64(storeFailures.WithLabelValues(storeDelete))
+	j.persist()
+	j.finish()
+	require.InDelta(t, savesBefore+1, testutil.ToFloat64(storeFailures.WithLabelValues(storeSave)), 0)
+	require.InDelta(t, deletesBefore+1, testutil.ToFloat64(storeFailures.WithLabelValues(storeDelete)), 0)
+}
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package jobs
+
+import (
+	"log/slog"
+
+	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtimeproject.edge-orchestrator.intel.com/v1"
+	nexus "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/controller"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
+)
+
+// CleanupOrphan schedules cleanup of a tenant that holds data in backends while its project no longer exists.
+// The cleanup goes through the regular job pipeline, except that there is no project watcher to report on.
+// It returns false when the tenant already has a job or the manager is not started.
+func (jm *JobManager) CleanupOrphan(tenantID string) bool {
+	jm.mu.Lock()
+	defer jm.mu.Unlock()
+
+	if jm.ctx == nil {
+		return false
+	}
+	if _, exists := jm.jobList[types.UID(tenantID)]; exists {
+		return false
+	}
+
+	slog.Info("Scheduling cleanup of orphaned tenant", logging.KeyTenantID, tenantID, logging.KeyAction, controller.CleanupTenant.String())
+	project := orphanProject(tenantID)
+	setProjectMetadata(project, controller.CleanupTenant)
+	job := newJob(project, jm.jobCfg, jm.backends, jm.store, jm.archiver)
+	job.orphan = true
+	jm.jobList[project.UID] = job
+	job.run(jm.ctx, controller.CleanupTenant)
+	return true
+}
+
+// orphanProject stands in for the missing project of an orphaned tenant.
+func orphanProject(tenantID string) *nexus.RuntimeprojectRuntimeProject {
+	return &nexus.RuntimeprojectRuntimeProject{
+		RuntimeProject: &projectv1.RuntimeProject{
+			ObjectMeta: metav1.ObjectMeta{
+				UID:  types.UID(tenantID),
+				Name: tenantID,
+			},
+		},
+	}
+}
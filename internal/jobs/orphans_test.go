@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	nexus "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/controller"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/store"
+)
+
+func TestCleanupOrphan(t *testing.T) {
+	unresolvable := func(_ context.Context, _ string) (*nexus.RuntimeprojectRuntimeProject, error) {
+		return nil, errors.New("project lookup not expected")
+	}
+
+	t.Run("Orphan cleaned up without watcher", func(t *testing.T) {
+		b := &fakeBackend{name: "first"}
+		st := store.NewMemoryStore()
+		jm := prepareJobManager(t, st, unresolvable, b)
+		defer jm.Stop()
+
+		require.True(t, jm.CleanupOrphan("orphan"), "Cleanup not scheduled")
+		require.Eventually(t, func() bool {
+			info, err := jm.Job("orphan")
+			return err == nil && info.Status == tenantDeleted.String()
+		}, time.Second, 10*time.Millisecond, "Orphan not cleaned up")
+		require.Equal(t, int32(1), b.cleanedUp.Load(), "Backend not cleaned up")
+
+		info, err := jm.Job("orphan")
+		require.NoError(t, err)
+		require.True(t, info.Orphan, "Job not reported as orphan")
+		require.False(t, jm.CleanupOrphan("orphan"), "Cleanup scheduled for tenant with a job")
+
+		records, err := st.List(t.Context())
+		require.NoError(t, err)
+		require.Empty(t, records, "Completed job not removed from store")
+	})
+
+	t.Run("Persisted orphan resumed without project lookup", func(t *testing.T) {
+		st := store.NewMemoryStore()
+		require.NoError(t, st.Save(t.Context(), store.Record{
+			TenantID: "orphan",
+			Name:     "orphan",
+			Action:   controller.CleanupTenant.String(),
+			Orphan:   true,
+		}))
+
+		b := &fakeBackend{name: "first"}
+		jm := prepareJobManager(t, st, unresolvable, b)
+		defer jm.Stop()
+
+		require.Eventually(t, func() bool {
+			info, err := jm.Job("orphan")
+			return err == nil && info.Status == tenantDeleted.String()
+		}, time.Second, 10*time.Millisecond, "Resumed orphan not cleaned up")
+	})
+
+	t.Run("Manager not started - cleanup not scheduled", func(t *testing.T) {
+		jm := New(make(chan controller.CommChannel), config.Job{}, nil, store.NewMemoryStore(), nil, unresolvable)
+		require.False(t, jm.CleanupOrphan("orphan"))
+	})
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package jobs
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/controller"
+)
+
+const (
+	eventStarted   = "started"
+	eventCoalesced = "coalesced"
+	eventSkipped   = "skipped"
+)
+
+var jobEvents = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "tenant_job_events_total",
+		Help: "Number of project events received by the job manager by result",
+	}, []string{"result"},
+)
+
+// tenantQueue holds the latest project event of every tenant. Events of a tenant waiting to be processed are
+// coalesced, so only the last desired action is processed, and events of a single tenant are never processed
+// concurrently.
+type tenantQueue struct {
+	queue workqueue.TypedInterface[types.UID]
+
+	mu     sync.Mutex
+	latest map[types.UID]controller.CommChannel
+}
+
+func newTenantQueue() *tenantQueue {
+	return &tenantQueue{
+		queue:  workqueue.NewTyped[types.UID](),
+		latest: map[types.UID]controller.CommChannel{},
+	}
+}
+
+// add replaces the pending event of the tenant, if any.
+func (q *tenantQueue) add(event controller.CommChannel) {
+	id := event.Project.UID
+
+	q.mu.Lock()
+	if prev, ok := q.latest[id]; ok {
+		jobEvents.WithLabelValues(eventCoalesced).Inc()
+		event = prev.Coalesce(event)
+	}
+	q.latest[id] = event
+	q.mu.Unlock()
+
+	q.queue.Add(id)
+}
+
+// get blocks until an event is available. The returned done function must be called once the event is processed.
+// It returns false when the queue is shut down.
+func (q *tenantQueue) get() (controller.CommChannel, func(), bool) {
+	for {
+		id, shutdown := q.queue.Get()
+		if shutdown {
+			return controller.CommChannel{}, nil, false
+		}
+
+		q.mu.Lock()
+		event, ok := q.latest[id]
+		delete(q.latest, id)
+		q.mu.Unlock()
+
+		// Event added while the tenant was being taken off the queue was already returned with it, the tenant was
+		// queued again regardless.
+		if !ok {
+			q.queue.Done(id)
+			continue
+		}
+		return event, func() { q.queue.Done(id) }, true
+	}
+}
+
+func (q *tenantQueue) shutDown() {
+	q.queue.ShutDown()
+}
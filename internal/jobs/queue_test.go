@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package jobs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtimeproject.edge-orchestrator.intel.com/v1"
+	nexus "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/controller"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/store"
+)
+
+func TestTenantQueue(t *testing.T) {
+	project := func(id string) *nexus.RuntimeprojectRuntimeProject {
+		return &nexus.RuntimeprojectRuntimeProject{RuntimeProject: &projectv1.RuntimeProject{ObjectMeta: metav1.ObjectMeta{UID: types.UID(id)}}}
+	}
+	q := newTenantQueue()
+	defer q.shutDown()
+
+	coalescedBefore := testutil.ToFloat64(jobEvents.WithLabelValues(eventCoalesced))
+	q.add(controller.CommChannel{Project: project("first"), Status: controller.CleanupTenant})
+	q.add(controller.CommChannel{Project: project("first"), Status: controller.InitializeTenant})
+	require.InDelta(t, coalescedBefore+1, testutil.ToFloat64(jobEvents.WithLabelValues(eventCoalesced)), 0)
+
+	event, done, ok := q.get()
+	require.True(t, ok)
+	require.Equal(t, controller.InitializeTenant, event.Status, "Latest action not kept")
+
+	// Events of the tenant being processed wait until it is done.
+	q.add(controller.CommChannel{Project: project("first"), Status: controller.InitializeTenant})
+	q.add(controller.CommChannel{Project: project("first"), Status: controller.CleanupTenant})
+	require.Equal(t, 0, q.queue.Len(), "Event of tenant being processed queued")
+	done()
+
+	event, done, ok = q.get()
+	require.True(t, ok)
+	require.Equal(t, controller.CleanupTenant, event.Status, "Latest action not kept")
+	done()
+
+	// Event added between taking the tenant off the queue and reading its event is returned right away, the tenant is
+	// queued again with no event left.
+	q.add(controller.CommChannel{Project: project("first"), Status: controller.InitializeTenant})
+	_, done, ok = q.get()
+	require.True(t, ok)
+	q.add(controller.CommChannel{Project: project("first"), Status: controller.CleanupTenant})
+	q.mu.Lock()
+	delete(q.latest, "first")
+	q.mu.Unlock()
+	done()
+
+	events := make(chan controller.CommChannel)
+	go func() {
+		for {
+			event, done, ok := q.get()
+			if !ok {
+				return
+			}
+			events <- event
+			done()
+		}
+	}()
+	select {
+	case event := <-events:
+		t.Fatalf("Tenant without pending event returned: %+v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+	q.add(controller.CommChannel{Project: project("second"), Status: controller.InitializeTenant})
+	require.Equal(t, types.UID("second"), (<-events).Project.UID)
+
+	q.shutDown()
+	_, _, ok = q.get()
+	require.False(t, ok, "Queue not shut down")
+}
+
+func TestEventCoalescing(t *testing.T) {
+	project := prepareProject(t)
+	// Project object is modified in place by the nexus client while jobs run.
+	id := string(project.UID)
+	b := &fakeBackend{name: "first"}
+	jm := prepareJobManager(t, store.NewMemoryStore(), func(_ context.Context, _ string) (*nexus.RuntimeprojectRuntimeProject, error) {
+		return project, nil
+	}, b)
+	defer jm.Stop()
+
+	waitStatus := func(status jobStatus) {
+		t.Helper()
+		require.Eventually(t, func() bool {
+			info, err := jm.Job(id)
+			return err == nil && info.Status == status.String()
+		}, time.Second, 10*time.Millisecond, "Job status different from expected")
+	}
+
+	jm.comSig <- controller.CommChannel{Project: project, Status: controller.InitializeTenant}
+	waitStatus(tenantCreated)
+
+	skippedBefore := testutil.ToFloat64(jobEvents.WithLabelValues(eventSkipped))
+	jm.comSig <- controller.CommChannel{Project: project, Status: controller.InitializeTenant}
+	require.Eventually(t, func() bool {
+		return testutil.ToFloat64(jobEvents.WithLabelValues(eventSkipped)) == skippedBefore+1
+	}, time.Second, 10*time.Millisecond, "Event of unchanged action not skipped")
+	require.Equal(t, int32(1), b.initialized.Load(), "Job restarted for unchanged action")
+
+	jm.comSig <- controller.CommChannel{Project: project, Status: controller.InitializeTenant, Force: true}
+	require.Eventually(t, func() bool { return b.initialized.Load() == 2 }, time.Second, 10*time.Millisecond, "Forced restart not run")
+
+	jm.comSig <- controller.CommChannel{Project: project, Status: controller.CleanupTenant}
+	waitStatus(tenantDeleted)
+	require.Equal(t, int32(1), b.cleanedUp.Load(), "Job not restarted for changed action")
+}
+
+func TestAlive(t *testing.T) {
+	jm := New(nil, config.Job{}, nil, store.NewMemoryStore(), nil, nil)
+	require.NoError(t, jm.Alive(t.Context()), "Idle job loop should be alive")
+
+	jm.busySince.Store(time.Now().Add(-time.Second).UnixNano())
+	require.NoError(t, jm.Alive(t.Context()), "Job loop processing an event should be alive")
+
+	jm.busySince.Store(time.Now().Add(-stuckTimeout - time.Second).UnixNano())
+	require.Error(t, jm.Alive(t.Context()), "Stuck job loop should not be alive")
+}
@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package jobs
+
+import (
+	"context"
+	"log/slog"
+
+	nexus "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/controller"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/util"
+)
+
+// Drift compares projects with the state of jobs and returns events restoring the desired state of tenants:
+//   - active project without a job or with a cleanup job is initialized,
+//   - deleted project with an initialization job, or without a job while its watcher still exists, is cleaned up,
+//   - created tenant is initialized again when reinitialize is set, restoring backends that lost tenant state.
+//
+// Failed and cancelled jobs are left for the operator.
+func (jm *JobManager) Drift(ctx context.Context, projects []*nexus.RuntimeprojectRuntimeProject, reinitialize bool) []controller.CommChannel {
+	var events, unknownDeleted []controller.CommChannel
+
+	jm.mu.RLock()
+	for _, project := range projects {
+		desired := controller.InitializeTenant
+		if project.Spec.Deleted {
+			desired = controller.CleanupTenant
+		}
+
+		j, exists := jm.jobList[project.UID]
+		if !exists {
+			if desired == controller.CleanupTenant {
+				unknownDeleted = append(unknownDeleted, controller.CommChannel{Project: project, Status: desired})
+			} else {
+				events = append(events, controller.CommChannel{Project: project, Status: desired})
+			}
+			continue
+		}
+
+		j.mu.Lock()
+		action := j.action
+		j.mu.Unlock()
+		status := jobStatus(j.status.Load())
+		if status == jobFailed || status == jobCancelled {
+			continue
+		}
+
+		if action != desired || (reinitialize && status == tenantCreated) {
+			events = append(events, controller.CommChannel{Project: project, Status: desired, Force: action == desired})
+		}
+	}
+	jm.mu.RUnlock()
+
+	// Tenant of a deleted project without a job is already cleaned up, unless its watcher still exists.
+	for _, event := range unknownDeleted {
+		_, err := event.Project.GetActiveWatchers(ctx, utility.AppName)
+		if nexus.IsChildNotFound(err) || nexus.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			slog.Warn("Failed to get watcher", logging.KeyTenantID, event.Project.UID, logging.Err(err))
+			continue
+		}
+		events = append(events, event)
+	}
+	return events
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package jobs
+
+import (
+	"testing"
+
+	projectwatchv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectactivewatcher.edge-orchestrator.intel.com/v1"
+	nexus "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/controller"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/store"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/util"
+)
+
+func TestDrift(t *testing.T) {
+	type jobState struct {
+		action controller.Action
+		status jobStatus
+	}
+
+	tests := []struct {
+		name         string
+		deleted      bool
+		watcher      bool
+		job          *jobState
+		reinitialize bool
+		expected     []controller.Action
+		forced       bool
+	}{
+		{name: "Active project without job", expected: []controller.Action{controller.InitializeTenant}},
+		{name: "Deleted project without job and watcher", deleted: true},
+		{name: "Deleted project without job with watcher", deleted: true, watcher: true, expected: []controller.Action{controller.CleanupTenant}},
+		{name: "Created tenant", job: &jobState{controller.InitializeTenant, tenantCreated}},
+		{
+			name:         "Created tenant reinitialized",
+			job:          &jobState{controller.InitializeTenant, tenantCreated},
+			reinitialize: true,
+			expected:     []controller.Action{controller.InitializeTenant},
+			forced:       true,
+		},
+		{name: "Deletion missed", deleted: true, job: &jobState{controller.InitializeTenant, tenantCreated}, expected: []controller.Action{controller.CleanupTenant}},
+		{name: "Undeletion missed", job: &jobState{controller.CleanupTenant, jobInProgress}, expected: []controller.Action{controller.InitializeTenant}},
+		{name: "Job in progress", job: &jobState{controller.InitializeTenant, jobInProgress}},
+		{name: "Failed job left for operator", deleted: true, job: &jobState{controller.InitializeTenant, jobFailed}},
+		{name: "Cancelled job left for operator", deleted: true, job: &jobState{controller.InitializeTenant, jobCancelled}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			project := prepareProject(t)
+			if tt.watcher {
+				_, err := project.AddActiveWatchers(t.Context(), &projectwatchv1.ProjectActiveWatcher{
+					ObjectMeta: metav1.ObjectMeta{Name: utility.AppName},
+				})
+				require.NoError(t, err)
+			}
+			project.UID = types.UID("tenant")
+			project.Spec.Deleted = tt.deleted
+
+			var jCfg config.Job
+			jm := New(nil, jCfg, nil, store.NewMemoryStore(), nil, nil)
+			if tt.job != nil {
+				j := newJob(project, jCfg, nil, jm.store, nil)
+				j.action = tt.job.action
+				j.status.Store(int32(tt.job.status))
+				jm.jobList[project.UID] = j
+			}
+
+			events := jm.Drift(t.Context(), []*nexus.RuntimeprojectRuntimeProject{project}, tt.reinitialize)
+			actions := make([]controller.Action, 0, len(events))
+			for _, event := range events {
+				require.Equal(t, project.UID, event.Project.UID)
+				require.Equal(t, tt.forced, event.Force, "Forced restart different from expected")
+				actions = append(actions, event.Status)
+			}
+			require.ElementsMatch(t, tt.expected, actions, "Enqueued actions different from expected")
+		})
+	}
+}
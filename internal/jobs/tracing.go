@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package jobs
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/controller"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
+)
+
+// Tracer is resolved lazily by the global provider, so spans are exported once tracing is set up.
+var tracer = otel.Tracer("github.com/open-edge-platform/o11y-tenant-controller/internal/jobs")
+
+// startJobSpan starts the root span of a job run. Backend calls of all attempts are recorded within it.
+func (j *job) startJobSpan(ctx context.Context, action controller.Action) (context.Context, trace.Span) {
+	_, projectName, orgName := extractLabelsFrom(j.project)
+	return tracer.Start(ctx, "tenant job "+action.String(), trace.WithNewRoot(), trace.WithAttributes(
+		attribute.String("tenant.id", string(j.project.UID)),
+		attribute.String("tenant.project", projectName),
+		attribute.String("tenant.org", orgName),
+		attribute.String("tenant.action", action.String()),
+		attribute.Bool("tenant.orphan", j.isOrphan()),
+	))
+}
+
+func endJobSpan(span trace.Span, status jobStatus) {
+	span.SetAttributes(attribute.String("tenant.job.status", status.String()))
+	if status == jobFailed {
+		span.SetStatus(codes.Error, "job failed")
+	}
+	span.End()
+}
+
+// startAttempt starts the span of a single try to perform the action, retries are recorded as separate spans.
+// Logs written within the attempt carry its number.
+func (j *job) startAttempt(ctx context.Context) (context.Context, trace.Span) {
+	j.mu.Lock()
+	attempt := j.attempts + 1
+	j.mu.Unlock()
+	ctx = logging.With(ctx, logging.KeyAttempt, attempt)
+	return tracer.Start(ctx, "attempt", trace.WithAttributes(attribute.Int("tenant.job.attempt", attempt)))
+}
+
+// startStep starts the span of a step executed by a single backend. Logs written within the step carry the backend name.
+func startStep(ctx context.Context, name string) (context.Context, trace.Span) {
+	ctx = logging.With(ctx, logging.KeyBackend, name)
+	return tracer.Start(ctx, "step "+name, trace.WithAttributes(attribute.String("tenant.backend", name)))
+}
+
+// endSpan records the error of the operation and ends its span.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	nexus "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/controller"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/store"
+)
+
+var (
+	recorderOnce sync.Once
+	recorder     *tracetest.SpanRecorder
+)
+
+// spanRecorder installs the global tracer provider once, the package tracer cannot switch to another provider later.
+func spanRecorder() *tracetest.SpanRecorder {
+	recorderOnce.Do(func() {
+		recorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	})
+	return recorder
+}
+
+func TestJobSpans(t *testing.T) {
+	rec := spanRecorder()
+	project := prepareProject(t)
+	id := string(project.UID)
+	b := &fakeBackend{name: "tracing", err: errors.New("backend failure")}
+	jm := prepareJobManager(t, store.NewMemoryStore(), func(_ context.Context, _ string) (*nexus.RuntimeprojectRuntimeProject, error) {
+		return project, nil
+	}, b)
+	defer jm.Stop()
+	jm.jobCfg.Backoff.Initial = time.Millisecond
+	jm.jobCfg.Backoff.Max = time.Millisecond
+	jm.jobCfg.Backoff.TimeMultiplier = 1
+	jm.jobCfg.Retry.MaxAttempts = 2
+
+	// Jobs of other tests may have traced the same tenant.
+	start := time.Now()
+	jm.comSig <- controller.CommChannel{Project: project, Status: controller.InitializeTenant}
+
+	var root sdktrace.ReadOnlySpan
+	require.Eventually(t, func() bool {
+		for _, span := range rec.Ended() {
+			if span.Name() == "tenant job InitializeTenant" && hasAttribute(span, attribute.String("tenant.id", id)) &&
+				!span.StartTime().Before(start) {
+				root = span
+				return true
+			}
+		}
+		return false
+	}, time.Second, 10*time.Millisecond, "Job span not ended")
+	require.Equal(t, codes.Error, root.Status().Code, "Failed job span status different from expected")
+	require.True(t, hasAttribute(root, attribute.String("tenant.job.status", jobFailed.String())))
+
+	children := map[string][]sdktrace.ReadOnlySpan{}
+	for _, span := range rec.Ended() {
+		if span.SpanContext().TraceID() == root.SpanContext().TraceID() {
+			children[span.Name()] = append(children[span.Name()], span)
+		}
+	}
+	require.Len(t, children["attempt"], 2, "Every retry attempt should have its span")
+	require.Len(t, children["step tracing"], 2, "Every backend call should have its span")
+	for i, attempt := range children["attempt"] {
+		require.Equal(t, root.SpanContext().SpanID(), attempt.Parent().SpanID())
+		require.True(t, hasAttribute(attempt, attribute.Int("tenant.job.attempt", i+1)))
+		require.Equal(t, codes.Error, attempt.Status().Code)
+	}
+	for _, step := range children["step tracing"] {
+		require.Equal(t, codes.Error, step.Status().Code)
+		require.NotEmpty(t, step.Events(), "Step error not recorded")
+	}
+}
+
+func hasAttribute(span sdktrace.ReadOnlySpan, expected attribute.KeyValue) bool {
+	for _, attr := range span.Attributes() {
+		if attr == expected {
+			return true
+		}
+	}
+	return false
+}
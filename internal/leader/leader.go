@@ -0,0 +1,233 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package leader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+)
+
+const (
+	namespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+	defaultName          = "observability-tenant-controller"
+	defaultLeaseDuration = 15 * time.Second
+	defaultRenewDeadline = 10 * time.Second
+	defaultRetryPeriod   = 2 * time.Second
+)
+
+// ErrLeadershipLost is returned by Run when the leadership is lost before the context is done.
+var ErrLeadershipLost = errors.New("leadership lost")
+
+var isLeader = promauto.NewGauge(
+	prometheus.GaugeOpts{
+		Name: "tenant_controller_leader",
+		Help: "Set to 1 when the controller instance is the leader",
+	},
+)
+
+// current is the leader observed by the election of this instance.
+var current struct {
+	mu       sync.RWMutex
+	identity string
+	leading  bool
+}
+
+// Current returns identity of the current leader, empty until one is elected, and whether this instance leads.
+func Current() (identity string, leading bool) {
+	current.mu.RLock()
+	defer current.mu.RUnlock()
+	return current.identity, current.leading
+}
+
+// Successor reports whether another instance is expected to take over once leading stops: the leadership was lost
+// while ctx of the instance is not done yet, or another leader has already been observed. It is false when the leader
+// shuts down, also with leader election disabled, so it releases what no other instance will keep using.
+func Successor(ctx context.Context) bool {
+	if ctx.Err() == nil {
+		return true
+	}
+	identity, leading := Current()
+	own, _ := Identity()
+	return identity != "" && !leading && identity != own
+}
+
+func setCurrent(identity string, leading bool) {
+	current.mu.Lock()
+	defer current.mu.Unlock()
+	current.identity, current.leading = identity, leading
+}
+
+// NewLock creates a lock of the configured type. Lease lock is used when type is not set.
+func NewLock(cfg config.LeaderElection, identity string) (resourcelock.Interface, error) {
+	cfg = withDefaults(cfg)
+	switch cfg.Lock {
+	case "", config.LockLease:
+		c, err := rest.InClusterConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read kubernetes service account token: %w", err)
+		}
+
+		client, err := kubernetes.NewForConfig(c)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create kubernetes client: %w", err)
+		}
+
+		namespace := cfg.Namespace
+		if namespace == "" {
+			ns, err := os.ReadFile(namespaceFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read pod namespace: %w", err)
+			}
+			namespace = strings.TrimSpace(string(ns))
+		}
+
+		return &resourcelock.LeaseLock{
+			LeaseMeta:  metav1.ObjectMeta{Name: cfg.Name, Namespace: namespace},
+			Client:     client.CoordinationV1(),
+			LockConfig: resourcelock.ResourceLockConfig{Identity: identity},
+		}, nil
+	case config.LockFile:
+		return NewFileLock(cfg.Path, identity)
+	default:
+		return nil, fmt.Errorf("unknown leader election lock type %q", cfg.Lock)
+	}
+}
+
+// Run calls lead once the instance becomes the leader. The context passed to lead is cancelled when ctx is done or the
+// leadership is lost, and the lock is released only after lead returns, so two instances never lead at the same time.
+// Without leader election enabled, lead is called right away. Run returns after lead returns.
+func Run(ctx context.Context, cfg config.LeaderElection, lock resourcelock.Interface, lead func(ctx context.Context)) error {
+	if !cfg.Enabled {
+		identity, _ := Identity()
+		setCurrent(identity, true)
+		defer setCurrent("", false)
+		isLeader.Set(1)
+		defer isLeader.Set(0)
+		lead(ctx)
+		return nil
+	}
+	cfg = withDefaults(cfg)
+
+	var (
+		mu     sync.Mutex
+		leadOK chan struct{}
+	)
+	wait := func() {
+		mu.Lock()
+		done := leadOK
+		mu.Unlock()
+		if done != nil {
+			<-done
+		}
+	}
+
+	// Elector runs with its own context, so the lock is released only after leading stops.
+	electorCtx, cancelElector := context.WithCancel(context.Background())
+	defer cancelElector()
+	go func() {
+		select {
+		case <-ctx.Done():
+			wait()
+			cancelElector()
+		case <-electorCtx.Done():
+		}
+	}()
+
+	elector, err := leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		Name:            cfg.Name,
+		LeaseDuration:   cfg.LeaseDuration,
+		RenewDeadline:   cfg.RenewDeadline,
+		RetryPeriod:     cfg.RetryPeriod,
+		ReleaseOnCancel: true,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(leaderCtx context.Context) {
+				mu.Lock()
+				if ctx.Err() != nil {
+					mu.Unlock()
+					return
+				}
+				done := make(chan struct{})
+				leadOK = done
+				mu.Unlock()
+				defer close(done)
+
+				slog.Info("Started leading", "identity", lock.Identity())
+				setCurrent(lock.Identity(), true)
+				defer setCurrent("", false)
+				isLeader.Set(1)
+				defer isLeader.Set(0)
+
+				leadCtx, cancel := context.WithCancel(leaderCtx)
+				defer cancel()
+				stop := context.AfterFunc(ctx, cancel)
+				defer stop()
+				lead(leadCtx)
+			},
+			OnStoppedLeading: func() {
+				slog.Info("Stopped leading", "identity", lock.Identity())
+			},
+			OnNewLeader: func(identity string) {
+				if identity != lock.Identity() {
+					setCurrent(identity, false)
+					slog.Info("Leader elected", "identity", identity)
+				}
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create leader elector: %w", err)
+	}
+
+	slog.Info("Campaigning for leadership", "name", cfg.Name, "identity", lock.Identity())
+	elector.Run(electorCtx)
+	wait()
+
+	if ctx.Err() == nil {
+		return ErrLeadershipLost
+	}
+	return nil
+}
+
+func withDefaults(cfg config.LeaderElection) config.LeaderElection {
+	if cfg.Name == "" {
+		cfg.Name = defaultName
+	}
+	if cfg.LeaseDuration <= 0 {
+		cfg.LeaseDuration = defaultLeaseDuration
+	}
+	if cfg.RenewDeadline <= 0 {
+		cfg.RenewDeadline = defaultRenewDeadline
+	}
+	if cfg.RetryPeriod <= 0 {
+		cfg.RetryPeriod = defaultRetryPeriod
+	}
+	return cfg
+}
+
+// Identity returns identity of the controller instance - the pod name in a cluster.
+func Identity() (string, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", fmt.Errorf("failed to read hostname: %w", err)
+	}
+	return hostname, nil
+}
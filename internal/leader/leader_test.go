@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package leader
+
+import (
+	"context"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+)
+
+func electionConfig() config.LeaderElection {
+	return config.LeaderElection{
+		Enabled: true,
+		Name:    "test",
+		// Lease duration is recorded in whole seconds.
+		LeaseDuration: time.Second,
+		RenewDeadline: 500 * time.Millisecond,
+		RetryPeriod:   100 * time.Millisecond,
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Run("Leader election disabled - lead called right away", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		led := make(chan struct{})
+		go func() {
+			<-led
+			cancel()
+		}()
+
+		require.NoError(t, Run(ctx, config.LeaderElection{}, nil, func(ctx context.Context) {
+			identity, leading := Current()
+			require.True(t, leading)
+			require.NotEmpty(t, identity)
+			close(led)
+			<-ctx.Done()
+		}))
+		_, leading := Current()
+		require.False(t, leading, "Leading reported after lead returned")
+	})
+
+	t.Run("Single leader at a time - leadership handed over on stop", func(t *testing.T) {
+		shared := &MemoryRecord{}
+		var leaders, maxLeaders atomic.Int32
+		lead := func(id string, led chan<- string) func(ctx context.Context) {
+			return func(ctx context.Context) {
+				n := leaders.Add(1)
+				defer leaders.Add(-1)
+				for {
+					m := maxLeaders.Load()
+					if n <= m || maxLeaders.CompareAndSwap(m, n) {
+						break
+					}
+				}
+				led <- id
+				<-ctx.Done()
+				// Leading takes a while to stop, the lock must not be released meanwhile.
+				time.Sleep(100 * time.Millisecond)
+			}
+		}
+
+		led := make(chan string, 2)
+		ctxs := map[string]context.CancelFunc{}
+		returned := map[string]chan error{}
+		for _, id := range []string{"first", "second"} {
+			ctx, cancel := context.WithCancel(t.Context())
+			ctxs[id] = cancel
+			ret := make(chan error, 1)
+			returned[id] = ret
+			go func() {
+				ret <- Run(ctx, electionConfig(), NewMemoryLock(id, shared), lead(id, led))
+			}()
+		}
+
+		var first string
+		select {
+		case first = <-led:
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "No leader elected")
+		}
+
+		ctxs[first]()
+		require.NoError(t, <-returned[first], "Leader stopped with an error")
+
+		var second string
+		select {
+		case second = <-led:
+			require.NotEqual(t, first, second, "Leadership not handed over")
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "Leadership not handed over")
+		}
+
+		ctxs[second]()
+		require.NoError(t, <-returned[second], "Leader stopped with an error")
+		require.Equal(t, int32(1), maxLeaders.Load(), "More than one leader at a time")
+	})
+}
+
+func TestSuccessor(t *testing.T) {
+	t.Run("Leader shut down - no successor", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		successor := make(chan bool, 1)
+		go func() {
+			_ = Run(ctx, electionConfig(), NewMemoryLock("only", &MemoryRecord{}), func(leadCtx context.Context) {
+				cancel()
+				<-leadCtx.Done()
+				successor <- Successor(ctx)
+			})
+		}()
+
+		select {
+		case s := <-successor:
+			require.False(t, s, "Successor reported on shutdown of the only leader")
+		case <-time.After(5 * time.Second):
+			require.Fail(t, "No leader elected")
+		}
+	})
+
+	t.Run("Leader election disabled - no successor on shutdown", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		require.NoError(t, Run(ctx, config.LeaderElection{}, nil, func(ctx context.Context) {
+			require.True(t, Successor(ctx), "Leading instance still running")
+			cancel()
+		}))
+		require.False(t, Successor(ctx))
+	})
+
+	t.Run("Another leader observed", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		setCurrent("other", false)
+		defer setCurrent("", false)
+		require.True(t, Successor(ctx))
+	})
+}
+
+func TestFileLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "lock.json")
+	first, err := NewFileLock(path, "first")
+	require.NoError(t, err)
+	second, err := NewFileLock(path, "second")
+	require.NoError(t, err)
+
+	_, _, err = first.Get(t.Context())
+	require.True(t, k8serrors.IsNotFound(err), "Missing lock not reported as not found")
+
+	require.NoError(t, first.Create(t.Context(), resourcelock.LeaderElectionRecord{HolderIdentity: "first"}))
+	require.True(t, k8serrors.IsAlreadyExists(second.Create(t.Context(), resourcelock.LeaderElectionRecord{HolderIdentity: "second"})))
+
+	record, _, err := second.Get(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, "first", record.HolderIdentity)
+
+	require.NoError(t, first.Update(t.Context(), resourcelock.LeaderElectionRecord{HolderIdentity: "first", LeaderTransitions: 1}))
+	require.True(t, k8serrors.IsConflict(second.Update(t.Context(), resourcelock.LeaderElectionRecord{HolderIdentity: "second"})),
+		"Update based on outdated record not rejected")
+
+	_, _, err = second.Get(t.Context())
+	require.NoError(t, err)
+	require.NoError(t, second.Update(t.Context(), resourcelock.LeaderElectionRecord{HolderIdentity: "second"}))
+}
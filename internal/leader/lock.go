@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package leader
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+)
+
+var leaseResource = schema.GroupResource{Group: "coordination.k8s.io", Resource: "leases"}
+
+// versionedRecord is a leader election record with a version used for optimistic concurrency,
+// similarly to resource version of a Lease.
+type versionedRecord struct {
+	Version int                               `json:"version"`
+	Record  resourcelock.LeaderElectionRecord `json:"record"`
+}
+
+// MemoryRecord holds the state of an in-memory lock shared by all candidates, e.g. within a test.
+type MemoryRecord struct {
+	mu     sync.Mutex
+	record *versionedRecord
+}
+
+// MemoryLock is an in-memory lock of a single candidate.
+type MemoryLock struct {
+	identity string
+	shared   *MemoryRecord
+	observed int
+}
+
+func NewMemoryLock(identity string, shared *MemoryRecord) *MemoryLock {
+	return &MemoryLock{identity: identity, shared: shared}
+}
+
+func (l *MemoryLock) Get(_ context.Context) (*resourcelock.LeaderElectionRecord, []byte, error) {
+	l.shared.mu.Lock()
+	defer l.shared.mu.Unlock()
+
+	if l.shared.record == nil {
+		return nil, nil, k8serrors.NewNotFound(leaseResource, l.Describe())
+	}
+	l.observed = l.shared.record.Version
+	return recordWithRaw(l.shared.record.Record)
+}
+
+func (l *MemoryLock) Create(_ context.Context, ler resourcelock.LeaderElectionRecord) error {
+	l.shared.mu.Lock()
+	defer l.shared.mu.Unlock()
+
+	if l.shared.record != nil {
+		return k8serrors.NewAlreadyExists(leaseResource, l.Describe())
+	}
+	l.shared.record = &versionedRecord{Version: 1, Record: ler}
+	l.observed = 1
+	return nil
+}
+
+func (l *MemoryLock) Update(_ context.Context, ler resourcelock.LeaderElectionRecord) error {
+	l.shared.mu.Lock()
+	defer l.shared.mu.Unlock()
+
+	if l.shared.record == nil {
+		return errors.New("lock not initialized, call get or create first")
+	}
+	if l.shared.record.Version != l.observed {
+		return k8serrors.NewConflict(leaseResource, l.Describe(), errors.New("lock modified by another candidate"))
+	}
+	l.shared.record = &versionedRecord{Version: l.observed + 1, Record: ler}
+	l.observed++
+	return nil
+}
+
+func (*MemoryLock) RecordEvent(string) {}
+
+func (l *MemoryLock) Identity() string {
+	return l.identity
+}
+
+func (*MemoryLock) Describe() string {
+	return "memory"
+}
+
+// FileLock keeps the leader election record in a local file, e.g. for running several instances on a single host.
+// Updates are atomic, yet not guarded against candidates writing the file at the very same time - it is not meant
+// for production use.
+type FileLock struct {
+	path     string
+	identity string
+	observed int
+	mu       sync.Mutex
+}
+
+func NewFileLock(path, identity string) (*FileLock, error) {
+	if path == "" {
+		return nil, errors.New("file lock path cannot be empty")
+	}
+	return &FileLock{path: path, identity: identity}, nil
+}
+
+func (l *FileLock) Get(_ context.Context) (*resourcelock.LeaderElectionRecord, []byte, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	r, err := l.read()
+	if err != nil {
+		return nil, nil, err
+	}
+	l.observed = r.Version
+	return recordWithRaw(r.Record)
+}
+
+func (l *FileLock) Create(_ context.Context, ler resourcelock.LeaderElectionRecord) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if _, err := l.read(); err == nil {
+		return k8serrors.NewAlreadyExists(leaseResource, l.Describe())
+	} else if !k8serrors.IsNotFound(err) {
+		return err
+	}
+	if err := l.write(versionedRecord{Version: 1, Record: ler}); err != nil {
+		return err
+	}
+	l.observed = 1
+	return nil
+}
+
+func (l *FileLock) Update(_ context.Context, ler resourcelock.LeaderElectionRecord) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	r, err := l.read()
+	if err != nil {
+		return err
+	}
+	if r.Version != l.observed {
+		return k8serrors.NewConflict(leaseResource, l.Describe(), errors.New("lock modified by another candidate"))
+	}
+	if err := l.write(versionedRecord{Version: r.Version + 1, Record: ler}); err != nil {
+		return err
+	}
+	l.observed = r.Version + 1
+	return nil
+}
+
+func (*FileLock) RecordEvent(string) {}
+
+func (l *FileLock) Identity() string {
+	return l.identity
+}
+
+func (l *FileLock) Describe() string {
+	return l.path
+}
+
+func (l *FileLock) read() (versionedRecord, error) {
+	var r versionedRecord
+	data, err := os.ReadFile(l.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return r, k8serrors.NewNotFound(leaseResource, l.Describe())
+	} else if err != nil {
+		return r, fmt.Errorf("failed to read lock file: %w", err)
+	}
+	if err := json.Unmarshal(data, &r); err != nil {
+		return r, fmt.Errorf("failed to unmarshal lock file: %w", err)
+	}
+	return r, nil
+}
+
+// write replaces the lock file atomically, so candidates never read a partially written record.
+func (l *FileLock) write(r versionedRecord) error {
+	data, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("failed to marshal lock record: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(l.path), filepath.Base(l.path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary lock file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write temporary lock file: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write temporary lock file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), l.path); err != nil {
+		return fmt.Errorf("failed to replace lock file: %w", err)
+	}
+	return nil
+}
+
+func recordWithRaw(ler resourcelock.LeaderElectionRecord) (*resourcelock.LeaderElectionRecord, []byte, error) {
+	raw, err := json.Marshal(ler)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal lock record: %w", err)
+	}
+	return &ler, raw, nil
+}
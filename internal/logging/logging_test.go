@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package logging
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+)
+
+func TestNew(t *testing.T) {
+	tests := map[string]struct {
+		cfg         config.Logging
+		expectedErr bool
+		check       func(t *testing.T, out string)
+	}{
+		"Defaults to JSON": {
+			check: func(t *testing.T, out string) {
+				var record map[string]any
+				require.NoError(t, json.Unmarshal([]byte(out), &record))
+				require.Equal(t, "message", record["msg"])
+				require.Equal(t, "tenant", record[KeyTenantID])
+			},
+		},
+		"Text format": {
+			cfg: config.Logging{Format: "text"},
+			check: func(t *testing.T, out string) {
+				require.Contains(t, out, "msg=message tenantID=tenant")
+			},
+		},
+		"Debug records dropped at warn level": {
+			cfg: config.Logging{Level: "warn"},
+			check: func(t *testing.T, out string) {
+				require.Empty(t, out)
+			},
+		},
+		"Unknown format": {cfg: config.Logging{Format: "xml"}, expectedErr: true},
+		"Unknown level":  {cfg: config.Logging{Level: "verbose"}, expectedErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger, err := New(tt.cfg, &buf)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			logger.Info("message", KeyTenantID, "tenant")
+			tt.check(t, buf.String())
+		})
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	require.Equal(t, slog.Default(), FromContext(t.Context()), "Default logger expected without logger in context")
+
+	var buf bytes.Buffer
+	logger, err := New(config.Logging{}, &buf)
+	require.NoError(t, err)
+
+	ctx := With(WithLogger(t.Context(), logger), KeyTenantID, "tenant", KeyAction, "InitializeTenant")
+	ctx = With(ctx, KeyAttempt, 2)
+	FromContext(ctx).Warn("failed", Err(errors.New("failure")))
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	require.Equal(t, "tenant", record[KeyTenantID])
+	require.Equal(t, "InitializeTenant", record[KeyAction])
+	require.InDelta(t, 2, record[KeyAttempt], 0)
+	require.Equal(t, "failure", record[KeyError])
+}
+
+func TestTraceIDs(t *testing.T) {
+	var buf bytes.Buffer
+	logger, err := New(config.Logging{}, &buf)
+	require.NoError(t, err)
+
+	ctx, span := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "span")
+	defer span.End()
+	logger.InfoContext(ctx, "message")
+
+	var record map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	require.Equal(t, span.SpanContext().TraceID().String(), record["traceID"])
+	require.Equal(t, span.SpanContext().SpanID().String(), record["spanID"])
+}
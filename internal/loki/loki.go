@@ -7,10 +7,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
 	"github.com/open-edge-platform/o11y-tenant-controller/internal/util"
 )
 
@@ -28,7 +28,8 @@ func CleanupTenant(ctx context.Context, urlCfg config.Loki) error {
 	if !ok {
 		return fmt.Errorf("failed to retrieve %q from context", utility.ContextKeyTenantID)
 	}
-	log.Printf("Deleting tenantID %q logs", tenantID)
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "Deleting tenant logs")
 
 	if err := flushIngesters(ctx, urlCfg, tenantID); err != nil {
 		return fmt.Errorf("failed to flush ingesters for tenantID %q: %w", tenantID, err)
@@ -42,7 +43,7 @@ func CleanupTenant(ctx context.Context, urlCfg config.Loki) error {
 		return fmt.Errorf("failed to check deletion status for tenantID %q: %w", tenantID, err)
 	}
 
-	log.Printf("TenantID %q logs deleted", tenantID)
+	logger.InfoContext(ctx, "Tenant logs deleted")
 	return nil
 }
 
@@ -63,7 +64,8 @@ func checkDeletionStatus(ctx context.Context, urlCfg config.Loki, tenantID strin
 	sleepTime := urlCfg.PollingRate
 	urlRaw := fmt.Sprintf("%v/loki/api/v1/delete", urlCfg.Backend)
 
-	log.Printf("Waiting for tenantID %q logs deletion in Loki...", tenantID)
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "Waiting for tenant logs deletion in Loki")
 	for {
 		if err := utility.SleepWithContext(ctx, sleepTime); err != nil {
 			return err
@@ -92,7 +94,7 @@ func checkDeletionStatus(ctx context.Context, urlCfg config.Loki, tenantID strin
 
 		// In case of empty response from loki, add new deletion request, and try checking again.
 		// Every retry have longer waiting period - up to MaxPolling rate.
-		log.Printf("Loki: empty deletion status response for tenant %q, retrying...", tenantID)
+		logger.WarnContext(ctx, "Empty deletion status response from Loki - requesting deletion again")
 
 		err = deleteLogsRequest(ctx, urlCfg, tenantID)
 		if err != nil {
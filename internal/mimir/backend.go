@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package mimir
+
+import (
+	"context"
+	"sync"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/backend"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+)
+
+// Backend exposes Mimir as a tenant backend.
+type Backend struct {
+	mu  sync.RWMutex
+	cfg config.Mimir
+}
+
+func NewBackend(deps backend.Dependencies) backend.TenantBackend {
+	return &Backend{cfg: deps.Endpoints.Mimir}
+}
+
+func (*Backend) Name() string {
+	return config.BackendMimir
+}
+
+// Initialize is a no-op - Mimir creates tenants implicitly on the first write.
+func (*Backend) Initialize(_ context.Context) error {
+	return nil
+}
+
+func (b *Backend) Cleanup(ctx context.Context) error {
+	return CleanupTenant(ctx, b.config())
+}
+
+func (b *Backend) Verify(ctx context.Context) error {
+	cfg := b.config()
+	return backend.VerifyReady(ctx, cfg.Ingester, cfg.Compactor)
+}
+
+// ListTenants lists tenants using the configured tenants endpoint.
+func (b *Backend) ListTenants(ctx context.Context) ([]string, error) {
+	cfg := b.config()
+	if cfg.Tenants == "" {
+		return nil, backend.ErrListingUnsupported
+	}
+	return backend.ListTenants(ctx, cfg.Tenants)
+}
+
+// Reconfigure applies changed endpoints. Running cleanups finish with the previous ones, retries use the new ones.
+func (b *Backend) Reconfigure(endpoints config.Endpoints) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.cfg = endpoints.Mimir
+}
+
+func (b *Backend) config() config.Mimir {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.cfg
+}
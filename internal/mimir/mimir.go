@@ -7,9 +7,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 
 	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
 	"github.com/open-edge-platform/o11y-tenant-controller/internal/util"
 )
 
@@ -23,7 +23,8 @@ func CleanupTenant(ctx context.Context, urlCfg config.Mimir) error {
 	if !ok {
 		return fmt.Errorf("failed to retrieve %q from context", utility.ContextKeyTenantID)
 	}
-	log.Printf("Deleting tenantID %q metrics", tenantID)
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "Deleting tenant metrics")
 
 	err := flushIngesters(ctx, urlCfg, tenantID)
 	if err != nil {
@@ -42,7 +43,7 @@ func CleanupTenant(ctx context.Context, urlCfg config.Mimir) error {
 		}
 	}
 
-	log.Printf("TenantID %q metrics deleted", tenantID)
+	logger.InfoContext(ctx, "Tenant metrics deleted")
 	return nil
 }
 
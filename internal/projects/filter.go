@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package projects
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+
+	pb "github.com/open-edge-platform/o11y-tenant-controller/api"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/auth"
+)
+
+// filter selects projects sent to a client, those of the orgs granted to it and matching the requested filter.
+type filter struct {
+	grant    auth.Grant
+	orgs     map[string]struct{}
+	statuses map[projectStatus]struct{}
+	glob     string
+	regex    *regexp.Regexp
+}
+
+// newFilter returns filter of the granted projects matching the requested one, which may be nil.
+func newFilter(grant auth.Grant, req *pb.ProjectFilter) (filter, error) {
+	f := filter{grant: grant, glob: req.GetNameGlob()}
+	if len(req.GetOrgNames()) > 0 {
+		f.orgs = make(map[string]struct{}, len(req.GetOrgNames()))
+		for _, org := range req.GetOrgNames() {
+			f.orgs[org] = struct{}{}
+		}
+	}
+	if len(req.GetStatuses()) > 0 {
+		f.statuses = make(map[projectStatus]struct{}, len(req.GetStatuses()))
+		for _, s := range req.GetStatuses() {
+			status := projectStatus(s)
+			if status != ProjectCreated && status != ProjectDeleted {
+				return filter{}, fmt.Errorf("invalid status %q, must be %v or %v", s, ProjectCreated, ProjectDeleted)
+			}
+			f.statuses[status] = struct{}{}
+		}
+	}
+	if _, err := path.Match(f.glob, ""); err != nil {
+		return filter{}, fmt.Errorf("invalid name glob %q: %w", f.glob, err)
+	}
+	if req.GetNameRegex() != "" {
+		regex, err := regexp.Compile("^(?:" + req.GetNameRegex() + ")$")
+		if err != nil {
+			return filter{}, fmt.Errorf("invalid name regex %q: %w", req.GetNameRegex(), err)
+		}
+		f.regex = regex
+	}
+	return f, nil
+}
+
+func (f filter) matches(project ProjectData) bool {
+	if !f.grant.Allows(project.OrgID) {
+		return false
+	}
+	if _, ok := f.orgs[project.OrgID]; f.orgs != nil && !ok {
+		return false
+	}
+	if _, ok := f.statuses[project.Status]; f.statuses != nil && !ok {
+		return false
+	}
+	if f.glob != "" {
+		if ok, _ := path.Match(f.glob, project.ProjectName); !ok {
+			return false
+		}
+	}
+	return f.regex == nil || f.regex.MatchString(project.ProjectName)
+}
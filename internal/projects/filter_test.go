@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package projects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	pb "github.com/open-edge-platform/o11y-tenant-controller/api"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/auth"
+)
+
+func TestFilter(t *testing.T) {
+	project := ProjectData{ProjectName: "team-alpha", OrgID: "first-org", Status: ProjectCreated}
+
+	tests := map[string]struct {
+		grant   auth.Grant
+		filter  *pb.ProjectFilter
+		matches bool
+	}{
+		"No filter":          {grant: auth.NewGrant(auth.AllOrgs), matches: true},
+		"Org not granted":    {grant: auth.NewGrant("second-org"), matches: false},
+		"Org listed":         {grant: auth.NewGrant(auth.AllOrgs), filter: &pb.ProjectFilter{OrgNames: []string{"second-org", "first-org"}}, matches: true},
+		"Org not listed":     {grant: auth.NewGrant(auth.AllOrgs), filter: &pb.ProjectFilter{OrgNames: []string{"second-org"}}, matches: false},
+		"Listed not granted": {grant: auth.NewGrant("second-org"), filter: &pb.ProjectFilter{OrgNames: []string{"first-org"}}, matches: false},
+		"Status listed":      {grant: auth.NewGrant(auth.AllOrgs), filter: &pb.ProjectFilter{Statuses: []string{"Created"}}, matches: true},
+		"Status not listed":  {grant: auth.NewGrant(auth.AllOrgs), filter: &pb.ProjectFilter{Statuses: []string{"Deleted"}}, matches: false},
+		"Glob matched":       {grant: auth.NewGrant(auth.AllOrgs), filter: &pb.ProjectFilter{NameGlob: "team-*"}, matches: true},
+		"Glob not matched":   {grant: auth.NewGrant(auth.AllOrgs), filter: &pb.ProjectFilter{NameGlob: "team"}, matches: false},
+		"Regex matched":      {grant: auth.NewGrant(auth.AllOrgs), filter: &pb.ProjectFilter{NameRegex: "team-(alpha|beta)"}, matches: true},
+		"Regex partial":      {grant: auth.NewGrant(auth.AllOrgs), filter: &pb.ProjectFilter{NameRegex: "alpha"}, matches: false},
+		"All criteria": {grant: auth.NewGrant("first-org"), filter: &pb.ProjectFilter{
+			OrgNames: []string{"first-org"}, Statuses: []string{"Created"}, NameGlob: "team-*", NameRegex: ".*alpha",
+		}, matches: true},
+		"Any criterion not matched": {grant: auth.NewGrant("first-org"), filter: &pb.ProjectFilter{
+			OrgNames: []string{"first-org"}, Statuses: []string{"Created"}, NameGlob: "team-*", NameRegex: ".*beta",
+		}, matches: false},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			f, err := newFilter(tc.grant, tc.filter)
+			require.NoError(t, err)
+			require.Equal(t, tc.matches, f.matches(project))
+		})
+	}
+}
+
+func TestInvalidFilter(t *testing.T) {
+	tests := map[string]*pb.ProjectFilter{
+		"Unknown status": {Statuses: []string{"Active"}},
+		"Invalid glob":   {NameGlob: "team-["},
+		"Invalid regex":  {NameRegex: "team-("},
+	}
+	for name, req := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := newFilter(auth.NewGrant(auth.AllOrgs), req)
+			require.Error(t, err)
+		})
+	}
+}
@@ -4,12 +4,35 @@
 package projects
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"log"
+	"slices"
+	"strings"
 	"sync"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/open-edge-platform/o11y-tenant-controller/api"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/auth"
+)
+
+var watchStarts = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "project_watch_starts_total",
+		Help: "Number of WatchProjects streams by whether they were resumed or started with a snapshot",
+	}, []string{"start"},
+)
+
+// Page sizes of ListProjects.
+const (
+	defaultPageSize = 100
+	maxPageSize     = 1000
 )
 
 type ProjectData struct {
@@ -29,12 +52,97 @@ type Server struct {
 	pb.UnimplementedProjectServiceServer
 
 	GrpcServer *grpc.Server
-	Port       int
 
 	Mu       *sync.RWMutex
 	Projects map[string]ProjectData
 
 	Clients *sync.Map
+
+	// epoch identifies revisions counted by this server, as they start over on restart.
+	epoch string
+	// revision counts changes of Projects, the latest of them are kept in changeLog for resuming clients of
+	// WatchProjects, tracked by watchers. All are guarded by Mu.
+	revision     uint64
+	changeLog    []loggedChange
+	changeLogMax int
+	watchers     map[*watcher]struct{}
+}
+
+// NewServer creates ProjectService server without projects, keeping changeLog latest changes of them.
+func NewServer(grpcServer *grpc.Server, changeLog int) *Server {
+	return &Server{
+		GrpcServer:   grpcServer,
+		Mu:           &sync.RWMutex{},
+		Projects:     make(map[string]ProjectData),
+		Clients:      &sync.Map{},
+		epoch:        rand.Text(),
+		changeLog:    make([]loggedChange, 0, changeLog),
+		changeLogMax: changeLog,
+		watchers:     make(map[*watcher]struct{}),
+	}
+}
+
+// change of a project not yet sent to a watcher. Before is nil for project the watcher has not been sent yet.
+type change struct {
+	before *ProjectData
+	after  ProjectData
+}
+
+// loggedChange is the change of the project made at the revision.
+type loggedChange struct {
+	revision uint64
+	key      string
+	change
+}
+
+// watcher collects changes not yet sent to its client, coalescing changes of the same project, so the pending ones
+// never outnumber the projects.
+type watcher struct {
+	notify  chan struct{}
+	pending map[string]*change
+}
+
+func newWatcher() *watcher {
+	return &watcher{notify: make(chan struct{}, 1), pending: make(map[string]*change)}
+}
+
+// add records the change as pending, keeping the state of the project the client was sent last.
+func (w *watcher) add(key string, c change) {
+	if p, ok := w.pending[key]; ok {
+		p.after = c.after
+	} else {
+		w.pending[key] = &c
+	}
+	select {
+	case w.notify <- struct{}{}:
+	default:
+	}
+}
+
+// SetProject stores the project under the key and notifies clients if it changed.
+func (s *Server) SetProject(key string, project ProjectData) {
+	s.Mu.Lock()
+	prev, ok := s.Projects[key]
+	if ok && prev == project {
+		s.Mu.Unlock()
+		return
+	}
+	s.Projects[key] = project
+	s.revision++
+
+	c := change{after: project}
+	if ok {
+		c.before = &prev
+	}
+	if len(s.changeLog) == s.changeLogMax {
+		s.changeLog = s.changeLog[1:]
+	}
+	s.changeLog = append(s.changeLog, loggedChange{revision: s.revision, key: key, change: c})
+	for w := range s.watchers {
+		w.add(key, c)
+	}
+	s.Mu.Unlock()
+	s.BroadcastUpdate()
 }
 
 func (s *Server) StreamProjectUpdates(_ *pb.EmptyRequest, stream pb.ProjectService_StreamProjectUpdatesServer) error {
@@ -68,18 +176,16 @@ func (s *Server) StreamProjectUpdates(_ *pb.EmptyRequest, stream pb.ProjectServi
 	}
 }
 
+// SendCurrentStateToClient sends projects of the orgs granted to the client.
 func (s *Server) SendCurrentStateToClient(stream pb.ProjectService_StreamProjectUpdatesServer) error {
+	grant := auth.FromContext(stream.Context())
 	s.Mu.RLock()
 	projectEntries := make([]*pb.ProjectEntry, 0, len(s.Projects))
 	for key, project := range s.Projects {
-		projectEntries = append(projectEntries, &pb.ProjectEntry{
-			Key: key,
-			Data: &pb.ProjectData{
-				ProjectName: project.ProjectName,
-				OrgName:     project.OrgID,
-				Status:      string(project.Status),
-			},
-		})
+		if !grant.Allows(project.OrgID) {
+			continue
+		}
+		projectEntries = append(projectEntries, entry(key, project))
 	}
 	s.Mu.RUnlock()
 
@@ -105,3 +211,173 @@ func (s *Server) BroadcastUpdate() {
 		return true
 	})
 }
+
+// WatchProjects sends a snapshot of projects of the orgs granted to the client and matching the requested filter,
+// followed by events with changes of them. Projects changed to no longer match, e.g. moved to an org not granted to
+// the client, are sent as removed. Stream resumed from a revision still in the change log starts with the changes
+// made since instead of the snapshot.
+func (s *Server) WatchProjects(req *pb.WatchProjectsRequest, stream pb.ProjectService_WatchProjectsServer) error {
+	f, err := newFilter(auth.FromContext(stream.Context()), req.GetFilter())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	w := newWatcher()
+
+	// Snapshot is taken together with registering the watcher, so no change is missed in between.
+	s.Mu.Lock()
+	s.watchers[w] = struct{}{}
+	resumed := s.resume(w, req)
+	event := &pb.ProjectEvent{Revision: s.revision, Epoch: s.epoch, Snapshot: !resumed}
+	if !resumed {
+		for key, project := range s.Projects {
+			if f.matches(project) {
+				event.Added = append(event.Added, entry(key, project))
+			}
+		}
+	}
+	s.Mu.Unlock()
+
+	defer func() {
+		s.Mu.Lock()
+		delete(s.watchers, w)
+		s.Mu.Unlock()
+	}()
+
+	if resumed {
+		// The first event is sent even without changes, confirming the stream is resumed.
+		watchStarts.WithLabelValues("resumed").Inc()
+		event = s.delta(w, f)
+	} else {
+		watchStarts.WithLabelValues("snapshot").Inc()
+		sortEntries(event.Added)
+	}
+	if err := stream.Send(event); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-w.notify:
+			event := s.delta(w, f)
+			if len(event.Added)+len(event.Updated)+len(event.Removed) == 0 {
+				continue
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// resume adds changes made after the requested revision to the watcher. It reports false if the stream cannot be
+// resumed, as no revision is requested, it is of another epoch or changes since are no longer logged. Called with Mu
+// locked.
+func (s *Server) resume(w *watcher, req *pb.WatchProjectsRequest) bool {
+	if req.GetRevision() == 0 || req.GetEpoch() != s.epoch || req.GetRevision() > s.revision ||
+		s.revision-req.GetRevision() > uint64(len(s.changeLog)) {
+		return false
+	}
+	for _, c := range s.changeLog {
+		if c.revision > req.GetRevision() {
+			w.add(c.key, c.change)
+		}
+	}
+	return true
+}
+
+// ListProjects returns a page of projects of the orgs granted to the client and matching the requested filter,
+// ordered by key. Page token holds the last key of the previous page, so projects added while paging are not
+// listed twice.
+func (s *Server) ListProjects(ctx context.Context, req *pb.ListProjectsRequest) (*pb.ListProjectsResponse, error) {
+	f, err := newFilter(auth.FromContext(ctx), req.GetFilter())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	pageSize := int(req.GetPageSize())
+	switch {
+	case pageSize < 0:
+		return nil, status.Error(codes.InvalidArgument, "page_size cannot be negative")
+	case pageSize == 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+	after, err := base64.RawURLEncoding.DecodeString(req.GetPageToken())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid page_token")
+	}
+
+	s.Mu.RLock()
+	res := &pb.ListProjectsResponse{Revision: s.revision, Epoch: s.epoch}
+	for key, project := range s.Projects {
+		if key > string(after) && f.matches(project) {
+			res.Projects = append(res.Projects, entry(key, project))
+		}
+	}
+	s.Mu.RUnlock()
+
+	sortEntries(res.Projects)
+	if len(res.Projects) > pageSize {
+		res.Projects = res.Projects[:pageSize]
+		res.NextPageToken = base64.RawURLEncoding.EncodeToString([]byte(res.Projects[pageSize-1].GetKey()))
+	}
+	return res, nil
+}
+
+// GetProject returns the project with the key. Projects of orgs not granted to the client are not found.
+func (s *Server) GetProject(ctx context.Context, req *pb.GetProjectRequest) (*pb.ProjectEntry, error) {
+	if req.GetKey() == "" {
+		return nil, status.Error(codes.InvalidArgument, "key cannot be empty")
+	}
+	s.Mu.RLock()
+	project, ok := s.Projects[req.GetKey()]
+	s.Mu.RUnlock()
+	if !ok || !auth.FromContext(ctx).Allows(project.OrgID) {
+		return nil, status.Errorf(codes.NotFound, "project %q not found", req.GetKey())
+	}
+	return entry(req.GetKey(), project), nil
+}
+
+// delta takes the pending changes of the watcher and returns event with those of projects matching the filter.
+func (s *Server) delta(w *watcher, f filter) *pb.ProjectEvent {
+	s.Mu.Lock()
+	pending := w.pending
+	w.pending = make(map[string]*change)
+	event := &pb.ProjectEvent{Revision: s.revision, Epoch: s.epoch}
+	s.Mu.Unlock()
+
+	for key, c := range pending {
+		wasVisible := c.before != nil && f.matches(*c.before)
+		visible := f.matches(c.after)
+		switch {
+		case !wasVisible && visible:
+			event.Added = append(event.Added, entry(key, c.after))
+		case wasVisible && !visible:
+			event.Removed = append(event.Removed, entry(key, *c.before))
+		case visible && *c.before != c.after:
+			event.Updated = append(event.Updated, entry(key, c.after))
+		}
+	}
+	sortEntries(event.Added)
+	sortEntries(event.Updated)
+	sortEntries(event.Removed)
+	return event
+}
+
+func entry(key string, project ProjectData) *pb.ProjectEntry {
+	return &pb.ProjectEntry{
+		Key: key,
+		Data: &pb.ProjectData{
+			ProjectName: project.ProjectName,
+			OrgName:     project.OrgID,
+			Status:      string(project.Status),
+		},
+	}
+}
+
+func sortEntries(entries []*pb.ProjectEntry) {
+	slices.SortFunc(entries, func(a, b *pb.ProjectEntry) int {
+		return strings.Compare(a.GetKey(), b.GetKey())
+	})
+}
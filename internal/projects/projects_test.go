@@ -0,0 +1,324 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package projects
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/open-edge-platform/o11y-tenant-controller/api"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/auth"
+)
+
+type fakeStream[T any] struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *T
+}
+
+func newFakeStream[T any](ctx context.Context) *fakeStream[T] {
+	return &fakeStream[T]{ctx: ctx, events: make(chan *T, 16)}
+}
+
+func (s *fakeStream[T]) Context() context.Context {
+	return s.ctx
+}
+
+func (s *fakeStream[T]) Send(event *T) error {
+	s.events <- event
+	return nil
+}
+
+func (s *fakeStream[T]) next(t *testing.T) *T {
+	t.Helper()
+	select {
+	case event := <-s.events:
+		return event
+	case <-time.After(time.Second):
+		require.FailNow(t, "No event received")
+		return nil
+	}
+}
+
+func (s *fakeStream[T]) none(t *testing.T) {
+	t.Helper()
+	select {
+	case event := <-s.events:
+		require.FailNow(t, "Unexpected event", "%v", event)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// watch starts WatchProjects with the request, stopping it at the end of the test.
+func watch(ctx context.Context, t *testing.T, s *Server, req *pb.WatchProjectsRequest) *fakeStream[pb.ProjectEvent] {
+	t.Helper()
+	ctx, cancel := context.WithCancel(ctx)
+	stream := newFakeStream[pb.ProjectEvent](ctx)
+	done := make(chan error, 1)
+	go func() { done <- s.WatchProjects(req, stream) }()
+	t.Cleanup(func() {
+		cancel()
+		require.ErrorIs(t, <-done, context.Canceled)
+	})
+	return stream
+}
+
+func keys(entries []*pb.ProjectEntry) []string {
+	keys := make([]string, 0, len(entries))
+	for _, e := range entries {
+		keys = append(keys, e.GetKey())
+	}
+	return keys
+}
+
+func TestWatchProjects(t *testing.T) {
+	s := NewServer(nil, 100)
+	s.SetProject("first", ProjectData{ProjectName: "first", OrgID: "first-org", Status: ProjectCreated})
+	s.SetProject("second", ProjectData{ProjectName: "second", OrgID: "second-org", Status: ProjectCreated})
+
+	all := watch(context.Background(), t, s, &pb.WatchProjectsRequest{})
+	granted := watch(auth.NewContext(context.Background(), auth.NewGrant("first-org")), t, s, &pb.WatchProjectsRequest{})
+
+	snapshot := all.next(t)
+	require.True(t, snapshot.GetSnapshot())
+	require.Equal(t, uint64(2), snapshot.GetRevision())
+	require.Equal(t, []string{"first", "second"}, keys(snapshot.GetAdded()))
+	snapshot = granted.next(t)
+	require.Equal(t, []string{"first"}, keys(snapshot.GetAdded()))
+
+	t.Run("Added project", func(t *testing.T) {
+		s.SetProject("third", ProjectData{ProjectName: "third", OrgID: "first-org", Status: ProjectCreated})
+		for _, stream := range []*fakeStream[pb.ProjectEvent]{all, granted} {
+			event := stream.next(t)
+			require.False(t, event.GetSnapshot())
+			require.Equal(t, uint64(3), event.GetRevision())
+			require.Equal(t, []string{"third"}, keys(event.GetAdded()))
+			require.Empty(t, event.GetUpdated())
+			require.Empty(t, event.GetRemoved())
+		}
+	})
+
+	t.Run("Unchanged project not sent", func(t *testing.T) {
+		s.SetProject("third", ProjectData{ProjectName: "third", OrgID: "first-org", Status: ProjectCreated})
+		all.none(t)
+		granted.none(t)
+	})
+
+	t.Run("Updated project", func(t *testing.T) {
+		s.SetProject("first", ProjectData{ProjectName: "first", OrgID: "first-org", Status: ProjectDeleted})
+		for _, stream := range []*fakeStream[pb.ProjectEvent]{all, granted} {
+			event := stream.next(t)
+			require.Equal(t, uint64(4), event.GetRevision())
+			require.Equal(t, []string{"first"}, keys(event.GetUpdated()))
+			require.Equal(t, string(ProjectDeleted), event.GetUpdated()[0].GetData().GetStatus())
+		}
+	})
+
+	t.Run("Changes of not granted orgs not sent", func(t *testing.T) {
+		s.SetProject("second", ProjectData{ProjectName: "renamed", OrgID: "second-org", Status: ProjectCreated})
+		require.Equal(t, []string{"second"}, keys(all.next(t).GetUpdated()))
+		granted.none(t)
+	})
+
+	t.Run("Project moved out of granted org removed", func(t *testing.T) {
+		s.SetProject("third", ProjectData{ProjectName: "third", OrgID: "second-org", Status: ProjectCreated})
+		require.Equal(t, []string{"third"}, keys(all.next(t).GetUpdated()))
+		event := granted.next(t)
+		require.Equal(t, []string{"third"}, keys(event.GetRemoved()))
+		require.Equal(t, "first-org", event.GetRemoved()[0].GetData().GetOrgName())
+	})
+
+	t.Run("Project moved into granted org added", func(t *testing.T) {
+		s.SetProject("second", ProjectData{ProjectName: "renamed", OrgID: "first-org", Status: ProjectCreated})
+		require.Equal(t, []string{"second"}, keys(all.next(t).GetUpdated()))
+		require.Equal(t, []string{"second"}, keys(granted.next(t).GetAdded()))
+	})
+}
+
+func TestWatchProjectsFiltered(t *testing.T) {
+	s := NewServer(nil, 100)
+	s.SetProject("first", ProjectData{ProjectName: "team-first", OrgID: "first-org", Status: ProjectCreated})
+	s.SetProject("second", ProjectData{ProjectName: "second", OrgID: "first-org", Status: ProjectCreated})
+	s.SetProject("third", ProjectData{ProjectName: "team-third", OrgID: "second-org", Status: ProjectCreated})
+
+	stream := watch(context.Background(), t, s, &pb.WatchProjectsRequest{Filter: &pb.ProjectFilter{
+		OrgNames: []string{"first-org"}, Statuses: []string{string(ProjectCreated)}, NameGlob: "team-*",
+	}})
+	require.Equal(t, []string{"first"}, keys(stream.next(t).GetAdded()))
+
+	s.SetProject("second", ProjectData{ProjectName: "team-second", OrgID: "first-org", Status: ProjectCreated})
+	require.Equal(t, []string{"second"}, keys(stream.next(t).GetAdded()))
+
+	// Project no longer matching the filter is removed.
+	s.SetProject("first", ProjectData{ProjectName: "team-first", OrgID: "first-org", Status: ProjectDeleted})
+	event := stream.next(t)
+	require.Equal(t, []string{"first"}, keys(event.GetRemoved()))
+	require.Equal(t, string(ProjectCreated), event.GetRemoved()[0].GetData().GetStatus())
+
+	s.SetProject("third", ProjectData{ProjectName: "team-third", OrgID: "second-org", Status: ProjectDeleted})
+	stream.none(t)
+
+	t.Run("Invalid filter rejected", func(t *testing.T) {
+		err := s.WatchProjects(&pb.WatchProjectsRequest{Filter: &pb.ProjectFilter{NameRegex: "("}},
+			newFakeStream[pb.ProjectEvent](context.Background()))
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestWatchProjectsCoalescesChanges(t *testing.T) {
+	s := NewServer(nil, 100)
+	w := newWatcher()
+	s.watchers[w] = struct{}{}
+
+	s.SetProject("first", ProjectData{ProjectName: "first", OrgID: "first-org", Status: ProjectCreated})
+	s.SetProject("first", ProjectData{ProjectName: "first", OrgID: "first-org", Status: ProjectDeleted})
+	s.SetProject("second", ProjectData{ProjectName: "second", OrgID: "first-org", Status: ProjectCreated})
+	event := s.delta(w, filter{grant: auth.NewGrant(auth.AllOrgs)})
+	require.Equal(t, uint64(3), event.GetRevision())
+	require.Equal(t, []string{"first", "second"}, keys(event.GetAdded()))
+	require.Equal(t, string(ProjectDeleted), event.GetAdded()[0].GetData().GetStatus())
+
+	// Project updated back to the state already sent is not sent again.
+	s.SetProject("second", ProjectData{ProjectName: "second", OrgID: "first-org", Status: ProjectDeleted})
+	s.SetProject("second", ProjectData{ProjectName: "second", OrgID: "first-org", Status: ProjectCreated})
+	event = s.delta(w, filter{grant: auth.NewGrant(auth.AllOrgs)})
+	require.Equal(t, uint64(5), event.GetRevision())
+	require.Empty(t, event.GetAdded())
+	require.Empty(t, event.GetUpdated())
+}
+
+func TestWatchProjectsResume(t *testing.T) {
+	s := NewServer(nil, 3)
+	s.SetProject("first", ProjectData{ProjectName: "first", OrgID: "first-org", Status: ProjectCreated})
+	s.SetProject("second", ProjectData{ProjectName: "second", OrgID: "first-org", Status: ProjectCreated})
+
+	stream := watch(context.Background(), t, s, &pb.WatchProjectsRequest{})
+	snapshot := stream.next(t)
+	require.True(t, snapshot.GetSnapshot())
+	require.NotEmpty(t, snapshot.GetEpoch())
+
+	// Changes made while the client is disconnected.
+	s.SetProject("first", ProjectData{ProjectName: "first", OrgID: "first-org", Status: ProjectDeleted})
+	require.Equal(t, []string{"first"}, keys(stream.next(t).GetUpdated()))
+	s.SetProject("third", ProjectData{ProjectName: "third", OrgID: "first-org", Status: ProjectCreated})
+	s.SetProject("third", ProjectData{ProjectName: "third", OrgID: "first-org", Status: ProjectDeleted})
+
+	t.Run("Resumed with missed changes", func(t *testing.T) {
+		resumed := watch(context.Background(), t, s, &pb.WatchProjectsRequest{Revision: snapshot.GetRevision(), Epoch: snapshot.GetEpoch()})
+		event := resumed.next(t)
+		require.False(t, event.GetSnapshot())
+		require.Equal(t, uint64(5), event.GetRevision())
+		require.Equal(t, []string{"third"}, keys(event.GetAdded()))
+		require.Equal(t, string(ProjectDeleted), event.GetAdded()[0].GetData().GetStatus())
+		require.Equal(t, []string{"first"}, keys(event.GetUpdated()))
+
+		s.SetProject("fourth", ProjectData{ProjectName: "fourth", OrgID: "first-org", Status: ProjectCreated})
+		require.Equal(t, []string{"fourth"}, keys(resumed.next(t).GetAdded()))
+	})
+
+	t.Run("Resumed at the latest revision", func(t *testing.T) {
+		latest := watch(context.Background(), t, s, &pb.WatchProjectsRequest{Revision: 6, Epoch: snapshot.GetEpoch()})
+		event := latest.next(t)
+		require.False(t, event.GetSnapshot())
+		require.Equal(t, uint64(6), event.GetRevision())
+		require.Empty(t, event.GetAdded())
+		latest.none(t)
+	})
+
+	tests := map[string]*pb.WatchProjectsRequest{
+		"No revision":        {Epoch: snapshot.GetEpoch()},
+		"Other epoch":        {Revision: 5, Epoch: "other"},
+		"Revision too old":   {Revision: snapshot.GetRevision(), Epoch: snapshot.GetEpoch()},
+		"Revision in future": {Revision: 7, Epoch: snapshot.GetEpoch()},
+	}
+	for name, req := range tests {
+		t.Run(name, func(t *testing.T) {
+			event := watch(context.Background(), t, s, req).next(t)
+			require.True(t, event.GetSnapshot())
+			require.Equal(t, uint64(6), event.GetRevision())
+			require.Equal(t, []string{"first", "fourth", "second", "third"}, keys(event.GetAdded()))
+		})
+	}
+}
+
+func TestListProjects(t *testing.T) {
+	s := NewServer(nil, 100)
+	for _, key := range []string{"e", "d", "c", "b", "a"} {
+		s.SetProject(key, ProjectData{ProjectName: "team-" + key, OrgID: "first-org", Status: ProjectCreated})
+	}
+	s.SetProject("f", ProjectData{ProjectName: "team-f", OrgID: "second-org", Status: ProjectCreated})
+	ctx := auth.NewContext(context.Background(), auth.NewGrant("first-org"))
+
+	t.Run("Pages", func(t *testing.T) {
+		res, err := s.ListProjects(ctx, &pb.ListProjectsRequest{PageSize: 2})
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "b"}, keys(res.GetProjects()))
+		require.Equal(t, uint64(6), res.GetRevision())
+		require.Equal(t, s.epoch, res.GetEpoch())
+
+		// Project added before the listed ones while paging is not listed.
+		s.SetProject("0", ProjectData{ProjectName: "team-0", OrgID: "first-org", Status: ProjectCreated})
+		res, err = s.ListProjects(ctx, &pb.ListProjectsRequest{PageSize: 2, PageToken: res.GetNextPageToken()})
+		require.NoError(t, err)
+		require.Equal(t, []string{"c", "d"}, keys(res.GetProjects()))
+
+		res, err = s.ListProjects(ctx, &pb.ListProjectsRequest{PageSize: 2, PageToken: res.GetNextPageToken()})
+		require.NoError(t, err)
+		require.Equal(t, []string{"e"}, keys(res.GetProjects()))
+		require.Empty(t, res.GetNextPageToken())
+	})
+
+	t.Run("Filtered", func(t *testing.T) {
+		res, err := s.ListProjects(ctx, &pb.ListProjectsRequest{Filter: &pb.ProjectFilter{NameRegex: "team-[a-c]"}})
+		require.NoError(t, err)
+		require.Equal(t, []string{"a", "b", "c"}, keys(res.GetProjects()))
+		require.Empty(t, res.GetNextPageToken())
+	})
+
+	tests := map[string]*pb.ListProjectsRequest{
+		"Negative page size": {PageSize: -1},
+		"Invalid page token": {PageToken: "not base64!"},
+		"Invalid filter":     {Filter: &pb.ProjectFilter{Statuses: []string{"Active"}}},
+	}
+	for name, req := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := s.ListProjects(ctx, req)
+			require.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestGetProject(t *testing.T) {
+	s := NewServer(nil, 100)
+	s.SetProject("first", ProjectData{ProjectName: "first", OrgID: "first-org", Status: ProjectCreated})
+	s.SetProject("second", ProjectData{ProjectName: "second", OrgID: "second-org", Status: ProjectCreated})
+	ctx := auth.NewContext(context.Background(), auth.NewGrant("first-org"))
+
+	project, err := s.GetProject(ctx, &pb.GetProjectRequest{Key: "first"})
+	require.NoError(t, err)
+	require.Equal(t, "first", project.GetKey())
+	require.Equal(t, "first-org", project.GetData().GetOrgName())
+	require.Equal(t, string(ProjectCreated), project.GetData().GetStatus())
+
+	tests := map[string]struct {
+		key  string
+		code codes.Code
+	}{
+		"Empty key":       {key: "", code: codes.InvalidArgument},
+		"Unknown project": {key: "third", code: codes.NotFound},
+		"Org not granted": {key: "second", code: codes.NotFound},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := s.GetProject(ctx, &pb.GetProjectRequest{Key: tc.key})
+			require.Equal(t, tc.code, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/backend"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
+)
+
+var orphanedTenants = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "orphaned_tenants",
+		Help: "Number of tenants holding data in the backend while their projects no longer exist",
+	}, []string{"backend"},
+)
+
+var orphanScans = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "tenant_orphan_scans_total",
+		Help: "Number of scans for orphaned tenants",
+	}, []string{"result"},
+)
+
+// OrphanCleaner schedules cleanup of tenants without projects.
+type OrphanCleaner interface {
+	CleanupOrphan(tenantID string) bool
+}
+
+// OrphanScanner periodically discovers tenants present in backends, e.g. Mimir and Loki, whose projects no longer
+// exist - for instance because they were deleted while the controller was down.
+type OrphanScanner struct {
+	cfg     config.Orphans
+	listers []backend.TenantLister
+	list    ProjectLister
+	cleaner OrphanCleaner
+	cancel  context.CancelFunc
+	stopped chan struct{}
+}
+
+// NewOrphanScanner creates scanner of tenants listed by the listers. Timeout defaults to the interval when not set.
+func NewOrphanScanner(cfg config.Orphans, listers []backend.TenantLister, list ProjectLister, cleaner OrphanCleaner) *OrphanScanner {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = cfg.Interval
+	}
+	return &OrphanScanner{
+		cfg:     cfg,
+		listers: listers,
+		list:    list,
+		cleaner: cleaner,
+	}
+}
+
+// TenantListers returns backends able to list their tenants.
+func TenantListers(backends []backend.TenantBackend) []backend.TenantLister {
+	var listers []backend.TenantLister
+	for _, b := range backends {
+		if l, ok := b.(backend.TenantLister); ok {
+			listers = append(listers, l)
+		}
+	}
+	return listers
+}
+
+// Start runs scan every configured interval. Scanning is disabled when the interval is not set.
+func (s *OrphanScanner) Start() {
+	if s.cfg.Interval <= 0 {
+		slog.Info("Orphaned tenant detection disabled")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.stopped = make(chan struct{})
+
+	go func() {
+		defer close(s.stopped)
+
+		ticker := time.NewTicker(s.cfg.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if _, err := s.Scan(ctx); err != nil {
+					slog.Error("Orphaned tenant scan failed", logging.Err(err))
+				}
+			}
+		}
+	}()
+}
+
+// Stop stops scanning and waits until the running scan returns.
+func (s *OrphanScanner) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+		<-s.stopped
+	}
+}
+
+// Scan diffs tenants listed by backends against live projects and returns the orphaned ones per backend.
+// Cleanup of orphans is scheduled when enabled. Backends that fail to list tenants are reported in the error,
+// while orphans found in the remaining ones are handled regardless.
+func (s *OrphanScanner) Scan(ctx context.Context) (map[string][]string, error) {
+	scanCtx, cancel := context.WithTimeout(ctx, s.cfg.Timeout)
+	defer cancel()
+
+	// Tenants are listed before projects, so data of a project created in between is not taken for an orphan.
+	var errs []error
+	tenants := make(map[string][]string, len(s.listers))
+	for _, l := range s.listers {
+		ids, err := l.ListTenants(scanCtx)
+		if errors.Is(err, backend.ErrListingUnsupported) {
+			continue
+		} else if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list %v tenants: %w", l.Name(), err))
+			continue
+		}
+		tenants[l.Name()] = ids
+	}
+
+	projects, err := s.list(scanCtx)
+	if err != nil {
+		orphanScans.WithLabelValues("failure").Inc()
+		return nil, fmt.Errorf("failed to list projects: %w", err)
+	}
+	live := make(map[string]struct{}, len(projects))
+	for _, p := range projects {
+		live[string(p.UID)] = struct{}{}
+	}
+
+	orphans := make(map[string][]string, len(tenants))
+	var toCleanup []string
+	for name, ids := range tenants {
+		for _, id := range ids {
+			if _, ok := live[id]; ok || slices.Contains(s.cfg.Ignore, id) {
+				continue
+			}
+			orphans[name] = append(orphans[name], id)
+			if !slices.Contains(toCleanup, id) {
+				toCleanup = append(toCleanup, id)
+			}
+		}
+		orphanedTenants.WithLabelValues(name).Set(float64(len(orphans[name])))
+		if len(orphans[name]) > 0 {
+			slog.Warn("Found orphaned tenants", logging.KeyBackend, name, "count", len(orphans[name]),
+				"tenantIDs", orphans[name])
+		}
+	}
+
+	if s.cfg.Cleanup {
+		for _, id := range toCleanup {
+			s.cleaner.CleanupOrphan(id)
+		}
+	}
+
+	if len(errs) > 0 {
+		orphanScans.WithLabelValues("failure").Inc()
+		return orphans, errors.Join(errs...)
+	}
+	orphanScans.WithLabelValues("success").Inc()
+	return orphans, nil
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtimeproject.edge-orchestrator.intel.com/v1"
+	nexus "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/backend"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+)
+
+type fakeLister struct {
+	name    string
+	tenants []string
+	err     error
+}
+
+func (l *fakeLister) Name() string {
+	return l.name
+}
+
+func (*fakeLister) Initialize(_ context.Context) error {
+	return nil
+}
+
+func (*fakeLister) Cleanup(_ context.Context) error {
+	return nil
+}
+
+func (*fakeLister) Verify(_ context.Context) error {
+	return nil
+}
+
+func (l *fakeLister) ListTenants(_ context.Context) ([]string, error) {
+	return l.tenants, l.err
+}
+
+type fakeCleaner struct {
+	tenants []string
+}
+
+func (c *fakeCleaner) CleanupOrphan(tenantID string) bool {
+	c.tenants = append(c.tenants, tenantID)
+	return true
+}
+
+func TestScan(t *testing.T) {
+	list := func(_ context.Context) ([]*nexus.RuntimeprojectRuntimeProject, error) {
+		return []*nexus.RuntimeprojectRuntimeProject{
+			{RuntimeProject: &projectv1.RuntimeProject{ObjectMeta: metav1.ObjectMeta{UID: "live"}}},
+		}, nil
+	}
+	listers := func() []backend.TenantLister {
+		return []backend.TenantLister{
+			&fakeLister{name: "mimir", tenants: []string{"live", "orphan", "system"}},
+			&fakeLister{name: "loki", err: backend.ErrListingUnsupported},
+			&fakeLister{name: "other", tenants: []string{"orphan", "other-orphan"}},
+		}
+	}
+
+	t.Run("Orphans reported without cleanup", func(t *testing.T) {
+		cleaner := &fakeCleaner{}
+		s := NewOrphanScanner(config.Orphans{Interval: time.Minute, Ignore: []string{"system"}}, listers(), list, cleaner)
+
+		orphans, err := s.Scan(t.Context())
+		require.NoError(t, err)
+		require.Equal(t, map[string][]string{"mimir": {"orphan"}, "other": {"orphan", "other-orphan"}}, orphans)
+		require.InDelta(t, 1, testutil.ToFloat64(orphanedTenants.WithLabelValues("mimir")), 0)
+		require.InDelta(t, 2, testutil.ToFloat64(orphanedTenants.WithLabelValues("other")), 0)
+		require.Empty(t, cleaner.tenants, "Cleanup scheduled while disabled")
+	})
+
+	t.Run("Cleanup scheduled once per orphan", func(t *testing.T) {
+		cleaner := &fakeCleaner{}
+		s := NewOrphanScanner(config.Orphans{Interval: time.Minute, Cleanup: true, Ignore: []string{"system"}}, listers(), list, cleaner)
+
+		_, err := s.Scan(t.Context())
+		require.NoError(t, err)
+		require.ElementsMatch(t, []string{"orphan", "other-orphan"}, cleaner.tenants)
+	})
+
+	t.Run("Listing failure of a backend - orphans of others handled", func(t *testing.T) {
+		cleaner := &fakeCleaner{}
+		failing := []backend.TenantLister{
+			&fakeLister{name: "mimir", err: errors.New("list failure")},
+			&fakeLister{name: "other", tenants: []string{"orphan"}},
+		}
+		s := NewOrphanScanner(config.Orphans{Interval: time.Minute, Cleanup: true}, failing, list, cleaner)
+
+		orphans, err := s.Scan(t.Context())
+		require.ErrorContains(t, err, "list failure")
+		require.Equal(t, map[string][]string{"other": {"orphan"}}, orphans)
+		require.Equal(t, []string{"orphan"}, cleaner.tenants)
+	})
+
+	t.Run("Project listing failure - nothing cleaned up", func(t *testing.T) {
+		cleaner := &fakeCleaner{}
+		s := NewOrphanScanner(config.Orphans{Interval: time.Minute, Cleanup: true}, listers(), func(_ context.Context) ([]*nexus.RuntimeprojectRuntimeProject, error) {
+			return nil, errors.New("list failure")
+		}, cleaner)
+
+		_, err := s.Scan(t.Context())
+		require.ErrorContains(t, err, "list failure")
+		require.Empty(t, cleaner.tenants, "Cleanup scheduled without projects")
+	})
+}
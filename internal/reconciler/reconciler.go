@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	nexus "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/controller"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
+)
+
+var reconcileRuns = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "tenant_reconcile_runs_total",
+		Help: "Number of reconciliations between projects and tenant jobs",
+	}, []string{"result"},
+)
+
+var reconcileDrift = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "tenant_reconcile_drift_total",
+		Help: "Number of tenant actions re-enqueued by reconciliation",
+	}, []string{"action"},
+)
+
+var reconcileDuration = promauto.NewHistogram(
+	prometheus.HistogramOpts{
+		Name: "tenant_reconcile_duration_seconds",
+		Help: "Duration of reconciliations between projects and tenant jobs",
+	},
+)
+
+// ProjectLister lists all runtime projects.
+type ProjectLister func(ctx context.Context) ([]*nexus.RuntimeprojectRuntimeProject, error)
+
+// DriftDetector compares projects with the known state of tenants.
+type DriftDetector interface {
+	Drift(ctx context.Context, projects []*nexus.RuntimeprojectRuntimeProject, reinitialize bool) []controller.CommChannel
+}
+
+// Reconciler periodically compares all projects with the state of tenant jobs and re-enqueues actions for any drift,
+// covering events missed by the project callbacks.
+type Reconciler struct {
+	comSig  chan<- controller.CommChannel
+	cfg     config.Reconcile
+	list    ProjectLister
+	drift   DriftDetector
+	cancel  context.CancelFunc
+	stopped chan struct{}
+}
+
+// defaultTimeout bounds reconciliations run on demand while periodic reconciliation is disabled.
+const defaultTimeout = 2 * time.Minute
+
+// New creates reconciler. Timeout defaults to the interval when not set, or to defaultTimeout when reconciliation is
+// disabled.
+func New(channel chan<- controller.CommChannel, cfg config.Reconcile, list ProjectLister, drift DriftDetector) *Reconciler {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = cfg.Interval
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = defaultTimeout
+	}
+	return &Reconciler{
+		comSig: channel,
+		cfg:    cfg,
+		list:   list,
+		drift:  drift,
+	}
+}
+
+// Start runs reconciliation every configured interval. Reconciliation is disabled when the interval is not set.
+func (r *Reconciler) Start() {
+	if r.cfg.Interval <= 0 {
+		slog.Info("Reconciliation disabled")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.stopped = make(chan struct{})
+
+	go func() {
+		defer close(r.stopped)
+
+		ticker := time.NewTicker(r.cfg.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := r.Reconcile(ctx); err != nil {
+					slog.Error("Reconciliation failed", logging.Err(err))
+				}
+			}
+		}
+	}()
+}
+
+// Stop stops reconciliation and waits until the running one returns, so no events are sent afterwards.
+func (r *Reconciler) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+		<-r.stopped
+	}
+}
+
+// ReconcileUntilSucceeded runs reconciliation, retrying failed ones every retry period until one succeeds. It returns
+// the error of the context when it is done first.
+func (r *Reconciler) ReconcileUntilSucceeded(ctx context.Context, retryPeriod time.Duration) error {
+	for {
+		err := r.Reconcile(ctx)
+		if err == nil {
+			return nil
+		}
+		slog.Error("Reconciliation failed, retrying", logging.Err(err), "retryPeriod", retryPeriod)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(retryPeriod):
+		}
+	}
+}
+
+// Reconcile lists all projects and enqueues actions restoring the desired state of their tenants.
+func (r *Reconciler) Reconcile(ctx context.Context) error {
+	start := time.Now()
+	defer func() {
+		reconcileDuration.Observe(time.Since(start).Seconds())
+	}()
+
+	listCtx, cancel := context.WithTimeout(ctx, r.cfg.Timeout)
+	defer cancel()
+	projects, err := r.list(listCtx)
+	if err != nil {
+		reconcileRuns.WithLabelValues("failure").Inc()
+		return fmt.Errorf("failed to list projects: %w", err)
+	}
+
+	events := r.drift.Drift(listCtx, projects, r.cfg.Reinitialize)
+	for _, event := range events {
+		slog.Info("Reconciliation enqueuing action", logging.KeyTenantID, event.Project.UID,
+			logging.KeyAction, event.Status.String())
+		select {
+		case r.comSig <- event:
+			reconcileDrift.WithLabelValues(event.Status.String()).Inc()
+		case <-ctx.Done():
+			reconcileRuns.WithLabelValues("failure").Inc()
+			return ctx.Err()
+		}
+	}
+
+	slog.Info("Reconciliation completed", "projects", len(projects), "enqueued", len(events))
+	reconcileRuns.WithLabelValues("success").Inc()
+	return nil
+}
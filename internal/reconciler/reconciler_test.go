@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	projectv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/runtimeproject.edge-orchestrator.intel.com/v1"
+	nexus "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/controller"
+)
+
+type fakeDrift struct {
+	reinitialize bool
+}
+
+// Drift enqueues initialization of every project.
+func (f *fakeDrift) Drift(_ context.Context, projects []*nexus.RuntimeprojectRuntimeProject, reinitialize bool) []controller.CommChannel {
+	f.reinitialize = reinitialize
+	events := make([]controller.CommChannel, 0, len(projects))
+	for _, p := range projects {
+		events = append(events, controller.CommChannel{Project: p, Status: controller.InitializeTenant})
+	}
+	return events
+}
+
+func TestReconcile(t *testing.T) {
+	projects := []*nexus.RuntimeprojectRuntimeProject{
+		{RuntimeProject: &projectv1.RuntimeProject{ObjectMeta: metav1.ObjectMeta{UID: "first"}}},
+		{RuntimeProject: &projectv1.RuntimeProject{ObjectMeta: metav1.ObjectMeta{UID: "second"}}},
+	}
+	list := func(_ context.Context) ([]*nexus.RuntimeprojectRuntimeProject, error) {
+		return projects, nil
+	}
+
+	t.Run("Drift enqueued", func(t *testing.T) {
+		channel := make(chan controller.CommChannel, len(projects))
+		drift := &fakeDrift{}
+		r := New(channel, config.Reconcile{Interval: time.Minute, Reinitialize: true}, list, drift)
+
+		driftBefore := testutil.ToFloat64(reconcileDrift.WithLabelValues(controller.InitializeTenant.String()))
+		require.NoError(t, r.Reconcile(t.Context()))
+		require.Len(t, channel, len(projects), "Number of enqueued events different from expected")
+		require.True(t, drift.reinitialize, "Reinitialize setting not passed")
+		require.InDelta(t, driftBefore+2, testutil.ToFloat64(reconcileDrift.WithLabelValues(controller.InitializeTenant.String())), 0)
+	})
+
+	t.Run("Listing failure", func(t *testing.T) {
+		failuresBefore := testutil.ToFloat64(reconcileRuns.WithLabelValues("failure"))
+		r := New(make(chan controller.CommChannel), config.Reconcile{Interval: time.Minute},
+			func(_ context.Context) ([]*nexus.RuntimeprojectRuntimeProject, error) {
+				return nil, errors.New("list failure")
+			}, &fakeDrift{})
+
+		require.ErrorContains(t, r.Reconcile(t.Context()), "list failure")
+		require.InDelta(t, failuresBefore+1, testutil.ToFloat64(reconcileRuns.WithLabelValues("failure")), 0)
+	})
+
+	t.Run("Failed reconciliation retried", func(t *testing.T) {
+		channel := make(chan controller.CommChannel, len(projects))
+		var calls int
+		r := New(channel, config.Reconcile{}, func(ctx context.Context) ([]*nexus.RuntimeprojectRuntimeProject, error) {
+			calls++
+			if calls < 3 {
+				return nil, errors.New("list failure")
+			}
+			return list(ctx)
+		}, &fakeDrift{})
+
+		require.NoError(t, r.ReconcileUntilSucceeded(t.Context(), time.Millisecond))
+		require.Equal(t, 3, calls, "Number of attempts different from expected")
+		require.Len(t, channel, len(projects), "Number of enqueued events different from expected")
+	})
+
+	t.Run("Retrying stopped when context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+		r := New(make(chan controller.CommChannel), config.Reconcile{},
+			func(_ context.Context) ([]*nexus.RuntimeprojectRuntimeProject, error) {
+				return nil, errors.New("list failure")
+			}, &fakeDrift{})
+
+		require.ErrorIs(t, r.ReconcileUntilSucceeded(ctx, time.Hour), context.Canceled)
+	})
+
+	t.Run("Periodic reconciliation stopped while channel is full", func(t *testing.T) {
+		channel := make(chan controller.CommChannel)
+		r := New(channel, config.Reconcile{Interval: 10 * time.Millisecond}, list, &fakeDrift{})
+		r.Start()
+
+		select {
+		case <-channel:
+		case <-time.After(time.Second):
+			require.Fail(t, "Reconciliation not started")
+		}
+		r.Stop()
+	})
+
+	t.Run("Reconciliation disabled", func(t *testing.T) {
+		channel := make(chan controller.CommChannel, len(projects))
+		r := New(channel, config.Reconcile{}, list, &fakeDrift{})
+		r.Start()
+		r.Stop()
+
+		require.NoError(t, r.Reconcile(t.Context()), "On demand reconciliation failed")
+		require.Len(t, channel, len(projects), "Number of enqueued events different from expected")
+	})
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package reload
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
+)
+
+var configInfo = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "tenant_controller_config_info",
+		Help: "Set for the hash of the active controller config file",
+	}, []string{"hash"},
+)
+
+var configReloads = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "tenant_controller_config_reloads_total",
+		Help: "Number of attempts to reload the controller config",
+	}, []string{"result"},
+)
+
+// ApplyFunc applies the new config, prev is the config active so far.
+type ApplyFunc func(prev, next config.Config)
+
+// Reloader watches the config file and applies its valid changes. Invalid config is rejected and the active one is kept.
+type Reloader struct {
+	path      string
+	overrides config.Overrides
+	interval  time.Duration
+
+	// mu guards the active config and serializes reloads.
+	mu   sync.Mutex
+	cfg  config.Config
+	hash string
+	// seen is the hash of the last file read, so a rejected file is not reported on every check.
+	seen string
+}
+
+// New reads the config file and creates reloader with it as the active config. Overrides are applied on every reload.
+func New(path string, overrides config.Overrides) (*Reloader, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %q: %w", path, err)
+	}
+	cfg, err := config.Parse(data, overrides)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := config.Hash(data)
+	configInfo.WithLabelValues(hash).Set(1)
+	return &Reloader{
+		path:      path,
+		overrides: overrides,
+		interval:  cfg.Controller.Reload.Interval,
+		cfg:       cfg,
+		hash:      hash,
+		seen:      hash,
+	}, nil
+}
+
+// Config returns the active config.
+func (r *Reloader) Config() config.Config {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.cfg
+}
+
+// Hash returns hash of the active config file.
+func (r *Reloader) Hash() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.hash
+}
+
+// Run reloads the config on SIGHUP and on changes of the file found every configured interval, until ctx is done.
+// File is watched by polling, as mounted ConfigMaps are updated by swapping symlinks.
+func (r *Reloader) Run(ctx context.Context, apply ApplyFunc) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	var tick <-chan time.Time
+	if r.interval > 0 {
+		ticker := time.NewTicker(r.interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	} else {
+		slog.Info("Watching of the config file disabled - config is reloaded on SIGHUP only")
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+			slog.Info("Reloading config on SIGHUP", "path", r.path)
+			if err := r.reload(apply, true); err != nil {
+				slog.Error("Failed to reload config - keeping the active one", logging.Err(err))
+			}
+		case <-tick:
+			if err := r.reload(apply, false); err != nil {
+				slog.Error("Failed to reload config - keeping the active one", logging.Err(err))
+			}
+		}
+	}
+}
+
+// reload reads the config file and applies it when its contents changed. Forced reload reports the file even if
+// it was already rejected.
+func (r *Reloader) reload(apply ApplyFunc, force bool) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		configReloads.WithLabelValues("failure").Inc()
+		return fmt.Errorf("failed to read file %q: %w", r.path, err)
+	}
+	hash := config.Hash(data)
+	if hash == r.hash || (hash == r.seen && !force) {
+		return nil
+	}
+	r.seen = hash
+
+	cfg, err := config.Parse(data, r.overrides)
+	if err != nil {
+		configReloads.WithLabelValues("failure").Inc()
+		return err
+	}
+
+	apply(r.cfg, cfg)
+	configInfo.DeleteLabelValues(r.hash)
+	configInfo.WithLabelValues(hash).Set(1)
+	r.cfg, r.hash = cfg, hash
+	configReloads.WithLabelValues("success").Inc()
+	slog.Info("Config reloaded", "hash", hash)
+	return nil
+}
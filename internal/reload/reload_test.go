@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package reload
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+)
+
+const baseConfig = `
+endpoints:
+  alertingmonitor: "localhost:51001"
+  backends:
+    - alertingmonitor
+job:
+  backoff:
+    initial: 3s
+`
+
+func TestReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(baseConfig), 0o600))
+
+	r, err := New(path, nil)
+	require.NoError(t, err)
+	initialHash := r.Hash()
+	require.Equal(t, 3*time.Second, r.Config().Job.Backoff.Initial)
+	require.InDelta(t, 1, testutil.ToFloat64(configInfo.WithLabelValues(initialHash)), 0)
+
+	var applied []config.Config
+	apply := func(prev, next config.Config) {
+		require.Equal(t, 3*time.Second, prev.Job.Backoff.Initial, "Previous config different from expected")
+		applied = append(applied, next)
+	}
+
+	t.Run("Unchanged file not applied", func(t *testing.T) {
+		require.NoError(t, r.reload(apply, false))
+		require.Empty(t, applied)
+	})
+
+	t.Run("Invalid config rejected", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte(baseConfig+"    max: 1s\n"), 0o600))
+		failuresBefore := testutil.ToFloat64(configReloads.WithLabelValues("failure"))
+
+		require.ErrorContains(t, r.reload(apply, false), "job.backoff.max")
+		require.Empty(t, applied)
+		require.Equal(t, initialHash, r.Hash(), "Active config replaced by invalid one")
+		require.InDelta(t, failuresBefore+1, testutil.ToFloat64(configReloads.WithLabelValues("failure")), 0)
+
+		require.NoError(t, r.reload(apply, false), "Rejected file reported again")
+		require.Error(t, r.reload(apply, true), "Forced reload did not report rejected file")
+	})
+
+	t.Run("Changed config applied", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte(baseConfig+"    max: 1m\n"), 0o600))
+
+		require.NoError(t, r.reload(apply, false))
+		require.Len(t, applied, 1)
+		require.Equal(t, time.Minute, applied[0].Job.Backoff.Max)
+		require.Equal(t, time.Minute, r.Config().Job.Backoff.Max)
+		require.NotEqual(t, initialHash, r.Hash())
+		require.InDelta(t, 1, testutil.ToFloat64(configInfo.WithLabelValues(r.Hash())), 0)
+		require.Equal(t, 1, testutil.CollectAndCount(configInfo), "Hash of previous config still exposed")
+	})
+}
@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package sre
+
+import (
+	"context"
+	"log/slog"
+
+	proto "github.com/open-edge-platform/o11y-sre-exporter/api/config-reloader"
+	"google.golang.org/grpc"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/backend"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
+)
+
+// Backend exposes sre-exporter as a tenant backend.
+type Backend struct {
+	conn *backend.Conn
+}
+
+func NewBackend(deps backend.Dependencies) backend.TenantBackend {
+	return &Backend{conn: deps.SreConn}
+}
+
+func (*Backend) Name() string {
+	return config.BackendSre
+}
+
+func (b *Backend) Initialize(ctx context.Context) error {
+	return b.conn.Call(func(conn *grpc.ClientConn) error {
+		return InitializeTenant(ctx, proto.NewManagementClient(conn))
+	})
+}
+
+func (b *Backend) Cleanup(ctx context.Context) error {
+	return b.conn.Call(func(conn *grpc.ClientConn) error {
+		return CleanupTenant(ctx, proto.NewManagementClient(conn))
+	})
+}
+
+func (b *Backend) Verify(_ context.Context) error {
+	return b.conn.Verify(config.BackendSre)
+}
+
+// Reconfigure dials sre-exporter again when its endpoint or TLS settings changed. Running calls finish on the previous
+// connection, the previous one is kept when dialing fails.
+func (b *Backend) Reconfigure(endpoints config.Endpoints) {
+	if err := b.conn.Redial(endpoints.Sre, endpoints.TLS.Sre); err != nil {
+		slog.Error("Failed to reconfigure backend - using the previous connection", logging.KeyBackend, config.BackendSre,
+			logging.Err(err))
+	}
+}
@@ -6,12 +6,12 @@ package sre
 import (
 	"context"
 	"fmt"
-	"log"
 
 	proto "github.com/open-edge-platform/o11y-sre-exporter/api/config-reloader"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
 	"github.com/open-edge-platform/o11y-tenant-controller/internal/util"
 )
 
@@ -21,16 +21,17 @@ func InitializeTenant(ctx context.Context, sre proto.ManagementClient) error {
 		return fmt.Errorf("failed to retrieve %q from context", utility.ContextKeyTenantID)
 	}
 
-	log.Printf("Creating tenantID %q in sre-exporter", tenantID)
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "Creating tenant in sre-exporter")
 	_, err := sre.InitializeTenant(ctx, &proto.TenantRequest{Tenant: tenantID})
 	if status.Code(err) == codes.AlreadyExists {
-		log.Printf("TenantID %q already initialized in sre-exporter", tenantID)
+		logger.InfoContext(ctx, "Tenant already initialized in sre-exporter")
 		return nil
 	} else if err != nil {
 		return fmt.Errorf("failed to initialize tenantID %q in sre-exporter: %w", tenantID, err)
 	}
 
-	log.Printf("TenantID %q initialized in sre-exporter", tenantID)
+	logger.InfoContext(ctx, "Tenant initialized in sre-exporter")
 	return nil
 }
 
@@ -40,15 +41,16 @@ func CleanupTenant(ctx context.Context, sre proto.ManagementClient) error {
 		return fmt.Errorf("failed to retrieve %q from context", utility.ContextKeyTenantID)
 	}
 
-	log.Printf("Deleting tenantID %q in sre-exporter", tenantID)
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "Deleting tenant in sre-exporter")
 	_, err := sre.CleanupTenant(ctx, &proto.TenantRequest{Tenant: tenantID})
 	if status.Code(err) == codes.NotFound {
-		log.Printf("TenantID %q already deleted in sre-exporter", tenantID)
+		logger.InfoContext(ctx, "Tenant already deleted in sre-exporter")
 		return nil
 	} else if err != nil {
 		return fmt.Errorf("failed to delete tenantID %q in sre-exporter: %w", tenantID, err)
 	}
 
-	log.Printf("TenantID %q deleted in sre-exporter", tenantID)
+	logger.InfoContext(ctx, "Tenant deleted in sre-exporter")
 	return nil
 }
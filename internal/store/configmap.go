@@ -0,0 +1,203 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// writeTimeout bounds a single update of the batch, including retries of conflicts.
+	writeTimeout = time.Minute
+	// Delay between attempts of an update that conflicted with a concurrent one.
+	conflictBackoffInitial = 10 * time.Millisecond
+	conflictBackoffMax     = time.Second
+)
+
+// ConfigMapStore keeps records in a single ConfigMap - one data key per tenant. Changes are written by a single writer,
+// which applies all changes pending at the time in one update, so concurrent jobs do not conflict with each other.
+type ConfigMapStore struct {
+	client    kubernetes.Interface
+	namespace string
+	name      string
+
+	mu sync.Mutex
+	// pending holds the latest change of every tenant waiting for the writer - nil record deletes the tenant.
+	pending map[string]*Record
+	waiters []chan error
+	writing bool
+}
+
+func NewConfigMapStore(client kubernetes.Interface, namespace, name string) *ConfigMapStore {
+	return &ConfigMapStore{
+		client:    client,
+		namespace: namespace,
+		name:      name,
+		pending:   make(map[string]*Record),
+	}
+}
+
+func (s *ConfigMapStore) List(ctx context.Context) ([]Record, error) {
+	cm, err := s.client.CoreV1().ConfigMaps(s.namespace).Get(ctx, s.name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get configmap %v/%v: %w", s.namespace, s.name, err)
+	}
+
+	records := make([]Record, 0, len(cm.Data))
+	for key, data := range cm.Data {
+		var r Record
+		if err := json.Unmarshal([]byte(data), &r); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal record %q: %w", key, err)
+		}
+		records = append(records, r)
+	}
+	return records, nil
+}
+
+// Save waits until the record is written. When ctx is done first, the record is still written, unless replaced by
+// a later change of the tenant.
+func (s *ConfigMapStore) Save(ctx context.Context, record Record) error {
+	return s.enqueue(ctx, record.TenantID, &record)
+}
+
+// Delete waits until the record is deleted. When ctx is done first, the record is still deleted, unless replaced by
+// a later change of the tenant.
+func (s *ConfigMapStore) Delete(ctx context.Context, tenantID string) error {
+	return s.enqueue(ctx, tenantID, nil)
+}
+
+// enqueue hands the change over to the writer and waits for the result of the update that includes it.
+func (s *ConfigMapStore) enqueue(ctx context.Context, tenantID string, record *Record) error {
+	done := make(chan error, 1)
+
+	s.mu.Lock()
+	s.pending[tenantID] = record
+	s.waiters = append(s.waiters, done)
+	if !s.writing {
+		s.writing = true
+		go s.write(context.WithoutCancel(ctx))
+	}
+	s.mu.Unlock()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("failed to update configmap %v/%v: %w", s.namespace, s.name, ctx.Err())
+	}
+}
+
+// write applies pending changes in batches until there are none left.
+func (s *ConfigMapStore) write(ctx context.Context) {
+	for {
+		s.mu.Lock()
+		if len(s.pending) == 0 {
+			s.writing = false
+			s.mu.Unlock()
+			return
+		}
+		batch, waiters := s.pending, s.waiters
+		s.pending, s.waiters = make(map[string]*Record), nil
+		s.mu.Unlock()
+
+		writeCtx, cancel := context.WithTimeout(ctx, writeTimeout)
+		err := s.apply(writeCtx, batch)
+		cancel()
+		for _, done := range waiters {
+			done <- err
+		}
+	}
+}
+
+// apply writes the batch of changes in a single update.
+func (s *ConfigMapStore) apply(ctx context.Context, batch map[string]*Record) error {
+	data := make(map[string]string, len(batch))
+	for tenantID, record := range batch {
+		if record == nil {
+			continue
+		}
+		encoded, err := json.Marshal(record)
+		if err != nil {
+			return fmt.Errorf("failed to marshal record %q: %w", tenantID, err)
+		}
+		data[tenantID] = string(encoded)
+	}
+
+	return s.modify(ctx, func(cm *corev1.ConfigMap) bool {
+		changed := false
+		for tenantID, record := range batch {
+			if record == nil {
+				if _, ok := cm.Data[tenantID]; ok {
+					delete(cm.Data, tenantID)
+					changed = true
+				}
+				continue
+			}
+			if cm.Data == nil {
+				cm.Data = make(map[string]string)
+			}
+			cm.Data[tenantID] = data[tenantID]
+			changed = true
+		}
+		return changed
+	})
+}
+
+// modify applies change to the configmap, creating it when missing. The change reports whether an update is needed.
+// Conflicting updates are retried until ctx is done.
+func (s *ConfigMapStore) modify(ctx context.Context, change func(cm *corev1.ConfigMap) bool) error {
+	delay := conflictBackoffInitial
+	for {
+		err := s.tryModify(ctx, change)
+		if !k8serrors.IsConflict(err) {
+			if err != nil {
+				return fmt.Errorf("failed to update configmap %v/%v: %w", s.namespace, s.name, err)
+			}
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("failed to update configmap %v/%v: %w", s.namespace, s.name, errors.Join(ctx.Err(), err))
+		case <-time.After(delay):
+		}
+		delay = min(2*delay, conflictBackoffMax)
+	}
+}
+
+func (s *ConfigMapStore) tryModify(ctx context.Context, change func(cm *corev1.ConfigMap) bool) error {
+	cm, err := s.client.CoreV1().ConfigMaps(s.namespace).Get(ctx, s.name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		cm = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: s.name, Namespace: s.namespace}}
+		if !change(cm) {
+			return nil
+		}
+		_, err = s.client.CoreV1().ConfigMaps(s.namespace).Create(ctx, cm, metav1.CreateOptions{})
+		if k8serrors.IsAlreadyExists(err) {
+			// Created concurrently - retry as a conflict.
+			return k8serrors.NewConflict(corev1.Resource("configmaps"), s.name, err)
+		}
+		return err
+	} else if err != nil {
+		return err
+	}
+
+	if !change(cm) {
+		return nil
+	}
+	_, err = s.client.CoreV1().ConfigMaps(s.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+	return err
+}
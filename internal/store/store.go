@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+)
+
+const (
+	namespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+)
+
+// Record is a persisted state of a single tenant job.
+type Record struct {
+	// TenantID is the UID of the project.
+	TenantID string `json:"tenantId"`
+	// Name is the name of the project object, used to look the project up on resume.
+	Name           string    `json:"name"`
+	Action         string    `json:"action"`
+	Attempts       int       `json:"attempts"`
+	CompletedSteps []string  `json:"completedSteps,omitempty"`
+	StartedAt      time.Time `json:"startedAt"`
+	DeleteAt       time.Time `json:"deleteAt"`
+	Archive        string    `json:"archive,omitempty"`
+	// ArchiveTo and ArchiveWindows checkpoint the archive in progress, so a retry resumes after the exported windows.
+	ArchiveTo      time.Time `json:"archiveTo,omitzero"`
+	ArchiveWindows int       `json:"archiveWindows,omitempty"`
+	// Orphan is set for a tenant whose project no longer exists, the job is resumed without looking the project up.
+	Orphan    bool      `json:"orphan,omitempty"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// Store persists job records, so unfinished jobs can be resumed after controller restart.
+// Implementations must be safe for concurrent use.
+type Store interface {
+	List(ctx context.Context) ([]Record, error)
+	Save(ctx context.Context, record Record) error
+	Delete(ctx context.Context, tenantID string) error
+}
+
+// New creates a store of the configured type. Memory store is used when type is not set.
+func New(cfg config.Store) (Store, error) {
+	switch cfg.Type {
+	case "", config.StoreMemory:
+		return NewMemoryStore(), nil
+	case config.StoreFile:
+		return NewFileStore(cfg.Path)
+	case config.StoreConfigMap:
+		if cfg.ConfigMap.Name == "" {
+			return nil, errors.New("configmap store requires name")
+		}
+		c, err := rest.InClusterConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read kubernetes service account token: %w", err)
+		}
+
+		client, err := kubernetes.NewForConfig(c)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create kubernetes client: %w", err)
+		}
+
+		namespace := cfg.ConfigMap.Namespace
+		if namespace == "" {
+			ns, err := os.ReadFile(namespaceFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read pod namespace: %w", err)
+			}
+			namespace = strings.TrimSpace(string(ns))
+		}
+		return NewConfigMapStore(client, namespace, cfg.ConfigMap.Name), nil
+	default:
+		return nil, fmt.Errorf("unknown store type %q", cfg.Type)
+	}
+}
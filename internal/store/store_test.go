@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package store
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+)
+
+func TestStores(t *testing.T) {
+	stores := map[string]func(t *testing.T) Store{
+		"Memory store": func(_ *testing.T) Store {
+			return NewMemoryStore()
+		},
+		"File store": func(t *testing.T) Store {
+			s, err := NewFileStore(filepath.Join(t.TempDir(), "jobs", "jobs.json"))
+			require.NoError(t, err)
+			return s
+		},
+		"ConfigMap store": func(_ *testing.T) Store {
+			return NewConfigMapStore(fake.NewClientset(), "orch-platform", "jobs")
+		},
+	}
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			s := newStore(t)
+
+			records, err := s.List(t.Context())
+			require.NoError(t, err)
+			require.Empty(t, records, "Store not empty initially")
+
+			first := Record{
+				TenantID:       "foo",
+				Name:           "foo-name",
+				Action:         "CleanupTenant",
+				Attempts:       3,
+				CompletedSteps: []string{"loki"},
+				UpdatedAt:      time.Now().UTC().Truncate(time.Second),
+			}
+			second := Record{TenantID: "bar", Name: "bar-name", Action: "InitializeTenant"}
+			require.NoError(t, s.Save(t.Context(), first))
+			require.NoError(t, s.Save(t.Context(), second))
+
+			records, err = s.List(t.Context())
+			require.NoError(t, err)
+			require.ElementsMatch(t, []Record{first, second}, records)
+
+			first.Attempts = 4
+			require.NoError(t, s.Save(t.Context(), first))
+			require.NoError(t, s.Delete(t.Context(), second.TenantID))
+			require.NoError(t, s.Delete(t.Context(), "unknown"), "Deleting unknown record returned an error")
+
+			records, err = s.List(t.Context())
+			require.NoError(t, err)
+			require.Equal(t, []Record{first}, records)
+		})
+	}
+}
+
+func TestConfigMapStoreConcurrentWrites(t *testing.T) {
+	client := fake.NewClientset()
+	// Updates conflict more times than a default retry allows.
+	var conflicts atomic.Int32
+	client.PrependReactor("update", "configmaps", func(k8stesting.Action) (bool, runtime.Object, error) {
+		if conflicts.Add(1) <= 6 {
+			return true, nil, k8serrors.NewConflict(corev1.Resource("configmaps"), "jobs", nil)
+		}
+		return false, nil, nil
+	})
+	s := NewConfigMapStore(client, "orch-platform", "jobs")
+
+	var wg sync.WaitGroup
+	for i := range 50 {
+		wg.Go(func() {
+			require.NoError(t, s.Save(t.Context(), Record{TenantID: fmt.Sprintf("tenant-%d", i), Attempts: i}))
+		})
+	}
+	wg.Wait()
+
+	records, err := s.List(t.Context())
+	require.NoError(t, err)
+	require.Len(t, records, 50, "Concurrently saved records lost")
+
+	for i := range 25 {
+		wg.Go(func() {
+			require.NoError(t, s.Delete(t.Context(), fmt.Sprintf("tenant-%d", i)))
+		})
+	}
+	wg.Wait()
+
+	records, err = s.List(t.Context())
+	require.NoError(t, err)
+	require.Len(t, records, 25, "Concurrently deleted records kept")
+}
+
+func TestFileStorePersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "jobs.json")
+	s, err := NewFileStore(path)
+	require.NoError(t, err)
+	require.NoError(t, s.Save(t.Context(), Record{TenantID: "foo", Action: "CleanupTenant"}))
+
+	reopened, err := NewFileStore(path)
+	require.NoError(t, err)
+	records, err := reopened.List(t.Context())
+	require.NoError(t, err)
+	require.Equal(t, []Record{{TenantID: "foo", Action: "CleanupTenant"}}, records)
+
+	t.Run("Malformed file - error expected", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte("[]]"), 0o600))
+		_, err := reopened.List(t.Context())
+		require.Error(t, err)
+	})
+}
+
+func TestNew(t *testing.T) {
+	t.Run("Memory store by default", func(t *testing.T) {
+		s, err := New(config.Store{})
+		require.NoError(t, err)
+		require.IsType(t, &MemoryStore{}, s)
+	})
+
+	t.Run("File store", func(t *testing.T) {
+		s, err := New(config.Store{Type: config.StoreFile, Path: filepath.Join(t.TempDir(), "jobs.json")})
+		require.NoError(t, err)
+		require.IsType(t, &FileStore{}, s)
+	})
+
+	t.Run("File store without path - error expected", func(t *testing.T) {
+		_, err := New(config.Store{Type: config.StoreFile})
+		require.Error(t, err)
+	})
+
+	t.Run("Unknown store type - error expected", func(t *testing.T) {
+		_, err := New(config.Store{Type: "foo"})
+		require.ErrorContains(t, err, `unknown store type "foo"`)
+	})
+}
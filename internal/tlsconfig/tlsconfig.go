@@ -0,0 +1,198 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
+)
+
+// ServerCredentials returns credentials of the gRPC server. Client certificates are required and verified
+// when CA is configured. Plaintext is served when TLS is disabled.
+func ServerCredentials(cfg config.TLS) (credentials.TransportCredentials, error) {
+	if !cfg.Enabled {
+		return insecure.NewCredentials(), nil
+	}
+	tlsCfg, err := Server(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(tlsCfg), nil
+}
+
+// ClientCredentials returns credentials of a gRPC client. Plaintext is used when TLS is disabled.
+func ClientCredentials(cfg config.TLS) (credentials.TransportCredentials, error) {
+	if !cfg.Enabled {
+		return insecure.NewCredentials(), nil
+	}
+	tlsCfg, err := Client(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(tlsCfg), nil
+}
+
+// Server returns TLS config picking up rotated certificate and CA on every handshake.
+func Server(cfg config.TLS) (*tls.Config, error) {
+	if cfg.CertFile == "" || cfg.KeyFile == "" {
+		return nil, errors.New("server certificate and key must be set")
+	}
+	l := &loader{cfg: cfg}
+	if _, err := l.load(); err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			files := l.current()
+			c := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*files.cert},
+			}
+			if files.pool != nil {
+				c.ClientCAs = files.pool
+				c.ClientAuth = tls.RequireAndVerifyClientCert
+			}
+			return c, nil
+		},
+	}, nil
+}
+
+// Client returns TLS config picking up rotated certificate and CA on every handshake. Certificate is presented only
+// when configured and requested by the server.
+func Client(cfg config.TLS) (*tls.Config, error) {
+	l := &loader{cfg: cfg}
+	if _, err := l.load(); err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: cfg.ServerName,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			if cert := l.current().cert; cert != nil {
+				return cert, nil
+			}
+			return &tls.Certificate{}, nil
+		},
+		// Built-in verification uses CA fixed at creation, so the server is verified by VerifyConnection against
+		// the current one.
+		InsecureSkipVerify: true, //nolint:gosec // Server certificate is verified by VerifyConnection.
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			return verifyServer(cs, l.current().pool)
+		},
+	}, nil
+}
+
+func verifyServer(cs tls.ConnectionState, roots *x509.CertPool) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("server presented no certificate")
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range cs.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	// System roots are used when roots are nil.
+	_, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+		DNSName:       cs.ServerName,
+		Roots:         roots,
+		Intermediates: intermediates,
+	})
+	return err
+}
+
+// files are the parsed contents of the configured files.
+type files struct {
+	cert *tls.Certificate
+	pool *x509.CertPool
+}
+
+// loader reads the configured files again once any of them is modified.
+type loader struct {
+	cfg config.TLS
+
+	mu       sync.Mutex
+	loaded   bool
+	files    files
+	modTimes []time.Time
+}
+
+// current returns the files, reloading them when modified. Files failing to load are reported and the previous
+// ones are kept, so a partially written rotation does not break connections.
+func (l *loader) current() files {
+	f, err := l.load()
+	if err != nil {
+		slog.Error("Failed to reload TLS files - using the previous ones", logging.Err(err))
+	}
+	return f
+}
+
+func (l *loader) load() (files, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	modTimes, err := l.stat()
+	if err != nil {
+		return l.files, err
+	}
+	if l.loaded && slices.EqualFunc(modTimes, l.modTimes, time.Time.Equal) {
+		return l.files, nil
+	}
+
+	var f files
+	if l.cfg.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(l.cfg.CertFile, l.cfg.KeyFile)
+		if err != nil {
+			return l.files, fmt.Errorf("failed to load certificate: %w", err)
+		}
+		f.cert = &cert
+	}
+	if l.cfg.CAFile != "" {
+		pem, err := os.ReadFile(l.cfg.CAFile)
+		if err != nil {
+			return l.files, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		f.pool = x509.NewCertPool()
+		if !f.pool.AppendCertsFromPEM(pem) {
+			return l.files, fmt.Errorf("no certificates found in CA file %q", l.cfg.CAFile)
+		}
+	}
+
+	if l.loaded {
+		slog.Info("TLS files reloaded", "cert", l.cfg.CertFile, "ca", l.cfg.CAFile)
+	}
+	l.files, l.modTimes, l.loaded = f, modTimes, true
+	return f, nil
+}
+
+// stat returns modification times of the configured files. Symlinks are followed, so swapping them,
+// as done for mounted secrets, is detected.
+func (l *loader) stat() ([]time.Time, error) {
+	var times []time.Time
+	for _, path := range []string{l.cfg.CertFile, l.cfg.KeyFile, l.cfg.CAFile} {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to stat %q: %w", path, err)
+		}
+		times = append(times, info.ModTime())
+	}
+	return times, nil
+}
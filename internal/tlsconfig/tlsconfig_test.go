@@ -0,0 +1,205 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+)
+
+type authority struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newAuthority(t *testing.T) authority {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return authority{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue writes certificate signed by the authority and its key to dir, returning their paths.
+func (a authority) issue(t *testing.T, dir, name string) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, a.cert, &key.PublicKey, a.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certPath, keyPath := filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600))
+	require.NoError(t, os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600))
+	return certPath, keyPath
+}
+
+func writeCA(t *testing.T, path string, authorities ...authority) {
+	t.Helper()
+
+	var data []byte
+	for _, a := range authorities {
+		data = append(data, a.pem...)
+	}
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+}
+
+// serve accepts TLS connections and completes their handshakes until the listener is closed.
+func serve(t *testing.T, cfg *tls.Config) string {
+	t.Helper()
+
+	lis, err := tls.Listen("tcp", "127.0.0.1:0", cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() { lis.Close() })
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			_ = conn.(*tls.Conn).Handshake()
+			conn.Close()
+		}
+	}()
+	return lis.Addr().String()
+}
+
+func handshake(addr string, cfg *tls.Config) error {
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: time.Second}, "tcp", addr, cfg)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	// Client certificate is verified by the server after the client finished its part of the handshake.
+	_, err = conn.Read(make([]byte, 1))
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	return err
+}
+
+func TestMutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newAuthority(t)
+	caPath := filepath.Join(dir, "ca.crt")
+	writeCA(t, caPath, ca)
+	serverCert, serverKey := ca.issue(t, dir, "server")
+	clientCert, clientKey := ca.issue(t, dir, "client")
+
+	serverCfg, err := Server(config.TLS{Enabled: true, CertFile: serverCert, KeyFile: serverKey, CAFile: caPath})
+	require.NoError(t, err)
+	addr := serve(t, serverCfg)
+
+	t.Run("Client with certificate accepted", func(t *testing.T) {
+		clientCfg, err := Client(config.TLS{Enabled: true, CertFile: clientCert, KeyFile: clientKey, CAFile: caPath, ServerName: "server"})
+		require.NoError(t, err)
+		require.NoError(t, handshake(addr, clientCfg))
+	})
+
+	t.Run("Client without certificate rejected", func(t *testing.T) {
+		clientCfg, err := Client(config.TLS{Enabled: true, CAFile: caPath, ServerName: "server"})
+		require.NoError(t, err)
+		require.Error(t, handshake(addr, clientCfg))
+	})
+
+	t.Run("Server name mismatch rejected", func(t *testing.T) {
+		clientCfg, err := Client(config.TLS{Enabled: true, CertFile: clientCert, KeyFile: clientKey, CAFile: caPath, ServerName: "other"})
+		require.NoError(t, err)
+		require.Error(t, handshake(addr, clientCfg))
+	})
+
+	t.Run("Untrusted server rejected", func(t *testing.T) {
+		otherPath := filepath.Join(t.TempDir(), "ca.crt")
+		writeCA(t, otherPath, newAuthority(t))
+		clientCfg, err := Client(config.TLS{Enabled: true, CertFile: clientCert, KeyFile: clientKey, CAFile: otherPath, ServerName: "server"})
+		require.NoError(t, err)
+		require.Error(t, handshake(addr, clientCfg))
+	})
+}
+
+func TestRotation(t *testing.T) {
+	dir := t.TempDir()
+	oldCA, newCA := newAuthority(t), newAuthority(t)
+	caPath := filepath.Join(dir, "ca.crt")
+	writeCA(t, caPath, oldCA)
+	serverCert, serverKey := oldCA.issue(t, dir, "server")
+
+	serverCfg, err := Server(config.TLS{Enabled: true, CertFile: serverCert, KeyFile: serverKey})
+	require.NoError(t, err)
+	addr := serve(t, serverCfg)
+
+	clientCfg, err := Client(config.TLS{Enabled: true, CAFile: caPath, ServerName: "server"})
+	require.NoError(t, err)
+	require.NoError(t, handshake(addr, clientCfg))
+
+	// Server certificate is rotated to one issued by the new CA, while the client trusts both during the transition.
+	newCA.issue(t, dir, "server")
+	writeCA(t, caPath, oldCA, newCA)
+	later := time.Now().Add(time.Minute)
+	for _, path := range []string{serverCert, serverKey, caPath} {
+		require.NoError(t, os.Chtimes(path, later, later))
+	}
+	require.NoError(t, handshake(addr, clientCfg), "Rotated files not picked up")
+
+	// Old CA is dropped, server keeps presenting the rotated certificate.
+	writeCA(t, caPath, newCA)
+	later = later.Add(time.Minute)
+	require.NoError(t, os.Chtimes(caPath, later, later))
+	require.NoError(t, handshake(addr, clientCfg), "Server certificate not rotated")
+
+	// Broken rotation keeps the previous files.
+	require.NoError(t, os.WriteFile(caPath, []byte("garbage"), 0o600))
+	require.NoError(t, handshake(addr, clientCfg), "Previous files not kept")
+}
+
+func TestServerRequiresCertificate(t *testing.T) {
+	_, err := Server(config.TLS{Enabled: true})
+	require.Error(t, err)
+
+	_, err = Client(config.TLS{Enabled: true, CertFile: "missing.crt", KeyFile: "missing.key"})
+	require.Error(t, err)
+}
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/config"
+)
+
+func TestSetup(t *testing.T) {
+	tests := map[string]struct {
+		cfg         config.Tracing
+		expectedErr bool
+	}{
+		"Disabled":         {cfg: config.Tracing{Exporter: "unknown"}},
+		"Stdout exporter":  {cfg: config.Tracing{Enabled: true, Exporter: config.ExporterStdout}},
+		"OTLP exporter":    {cfg: config.Tracing{Enabled: true, Endpoint: "localhost:4317", Insecure: true}},
+		"Unknown exporter": {cfg: config.Tracing{Enabled: true, Exporter: "unknown"}, expectedErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			shutdown, err := Setup(t.Context(), tt.cfg)
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, shutdown(t.Context()))
+		})
+	}
+}
+
+func TestSampler(t *testing.T) {
+	require.Equal(t, sdktrace.ParentBased(sdktrace.AlwaysSample()).Description(), sampler(0).Description())
+	require.Equal(t, sdktrace.ParentBased(sdktrace.AlwaysSample()).Description(), sampler(1).Description())
+	require.Equal(t, sdktrace.ParentBased(sdktrace.TraceIDRatioBased(0.25)).Description(), sampler(0.25).Description())
+}
@@ -10,6 +10,8 @@ import (
 	"net/http"
 	"net/url"
 	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 type VerifyMode string
@@ -27,6 +29,9 @@ const (
 	OrgNameLabel       string     = "runtimeorgs.runtimeorg.edge-orchestrator.intel.com"
 )
 
+// HTTPClient is used for all requests to backends. It records a span for each request and propagates trace context.
+var HTTPClient = &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
+
 func SleepWithContext(ctx context.Context, duration time.Duration) error {
 	timer := time.NewTimer(duration)
 	defer timer.Stop()
@@ -53,8 +58,7 @@ func PostReq(ctx context.Context, urlRaw string, tenantID string) error {
 
 	req.Header.Set("X-Scope-OrgID", tenantID)
 
-	client := http.DefaultClient
-	res, err := client.Do(req)
+	res, err := HTTPClient.Do(req)
 	if err != nil {
 		return fmt.Errorf("failed to reach endpoint %v: %w", urlRaw, err)
 	}
@@ -80,8 +84,7 @@ func GetReq(ctx context.Context, urlRaw string, tenantID string) ([]byte, error)
 
 	req.Header.Set("X-Scope-OrgID", tenantID)
 
-	client := http.DefaultClient
-	res, err := client.Do(req)
+	res, err := HTTPClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to reach endpoint %v: %w", urlRaw, err)
 	}
@@ -7,13 +7,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"log"
 	"time"
 
 	projectwatchv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectactivewatcher.edge-orchestrator.intel.com/v1"
 	nexus "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/open-edge-platform/o11y-tenant-controller/internal/logging"
 	"github.com/open-edge-platform/o11y-tenant-controller/internal/util"
 )
 
@@ -52,8 +52,9 @@ func DeleteWatcher(ctx context.Context, project *nexus.RuntimeprojectRuntimeProj
 		return fmt.Errorf("failed to delete watcher for tenant %q: %w", project.UID, err)
 	}
 
+	logger := logging.FromContext(ctx)
 	if nexus.IsNotFound(err) || nexus.IsChildNotFound(err) {
-		log.Printf("Watcher already deleted for tenantID %q", project.UID)
+		logger.InfoContext(ctx, "Watcher already deleted")
 		return nil
 	}
 
@@ -61,16 +62,16 @@ func DeleteWatcher(ctx context.Context, project *nexus.RuntimeprojectRuntimeProj
 		return err
 	}
 
-	log.Printf("Deleting watcher for tenantID %q", project.UID)
+	logger.InfoContext(ctx, "Deleting watcher")
 	err = project.DeleteActiveWatchers(ctx, utility.AppName)
 
 	if nexus.IsNotFound(err) || nexus.IsChildNotFound(err) {
-		log.Printf("Watcher already deleted for tenantID %q", project.UID)
+		logger.InfoContext(ctx, "Watcher already deleted")
 	} else if err != nil {
 		return fmt.Errorf("failed to delete watcher for tenantID %q: %w", project.UID, err)
 	}
 
-	log.Printf("Watcher for tenantID %q deleted", project.UID)
+	logger.InfoContext(ctx, "Watcher deleted")
 	return nil
 }
 
@@ -104,7 +105,8 @@ func createWatcher(ctx context.Context, project *nexus.RuntimeprojectRuntimeProj
 		return errors.New("failed to create watcher: project cannot be nil")
 	}
 
-	log.Printf("Creating watcher for tenantID %q", project.UID)
+	logger := logging.FromContext(ctx)
+	logger.InfoContext(ctx, "Creating watcher", logging.KeyStatus, status)
 	_, err := project.AddActiveWatchers(ctx, &projectwatchv1.ProjectActiveWatcher{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: utility.AppName,
@@ -120,7 +122,7 @@ func createWatcher(ctx context.Context, project *nexus.RuntimeprojectRuntimeProj
 		return fmt.Errorf("failed to create watcher for tenantID %q: %w", project.UID, err)
 	}
 
-	log.Printf("Watcher for tenantID %q created", project.UID)
+	logger.InfoContext(ctx, "Watcher created")
 	return nil
 }
 
@@ -133,13 +135,13 @@ func updateWatcher(ctx context.Context, watcher *nexus.ProjectactivewatcherProje
 	err := checkWatcher(ctx, watcher)
 	if err != nil {
 		if errors.As(err, &IDsDoNotMatchError{}) {
-			log.Printf("Skipped updating watcher for tenantID %q - actual and job's project IDs do not match", tenantID)
+			logging.FromContext(ctx).InfoContext(ctx, "Skipped updating watcher - actual and job's project IDs do not match")
 			return nil
 		}
 		return err
 	}
 
-	log.Printf("Updating watcher for tenantID %q", tenantID)
+	logging.FromContext(ctx).InfoContext(ctx, "Updating watcher", logging.KeyStatus, status)
 	watcher.Spec.StatusIndicator = status
 	watcher.Spec.Message = message
 	watcher.Spec.TimeStamp = safeUnixTime()